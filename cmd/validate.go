@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/aep-dev/aep-e2e-validator/pkg/validator"
 	"github.com/spf13/cobra"
@@ -17,6 +18,9 @@ var (
 	testNames      []string
 	headerFlags    []string
 	jsonOutput     bool
+	pollInterval   time.Duration
+	pollTimeout    time.Duration
+	invokeCustom   bool
 )
 
 func parseHeaders(raw []string) ([]validator.Header, error) {
@@ -64,6 +68,8 @@ var validateCmd = &cobra.Command{
 		}
 
 		v := validator.NewValidator(configPath, collection, allCollections, parent, testNames, headers, jsonOutput)
+		v.SetOperationPolling(pollInterval, pollTimeout)
+		v.SetInvokeCustomMethods(invokeCustom)
 		exitCode := v.Run()
 		if exitCode != validator.ExitCodeSuccess {
 			os.Exit(exitCode)
@@ -82,6 +88,10 @@ func init() {
 	validateCmd.Flags().StringSliceVar(&testNames, "tests", []string{}, "Comma-separated list of tests to run (e.g. aep-133-create)")
 	validateCmd.Flags().StringArrayVarP(&headerFlags, "header", "H", []string{}, "Headers to include in every request (format: key=value, repeatable)")
 	validateCmd.Flags().BoolVar(&jsonOutput, "json", false, "Output results as JSON")
+	validateCmd.Flags().DurationVar(&pollInterval, "operation-poll-interval", time.Second, "Interval between polls of long-running operations")
+	validateCmd.Flags().DurationVar(&pollTimeout, "operation-timeout", 2*time.Minute, "Maximum time to wait for a long-running operation to complete")
+
+	validateCmd.Flags().BoolVar(&invokeCustom, "invoke-custom-methods", false, "Invoke POST custom methods, which may have side effects")
 
 	validateCmd.MarkFlagRequired("config")
 }
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+)
+
+var TestAEP122NonExistentReference = Test{
+	Name:         "aep-122-nonexistent-reference",
+	URL:          "https://aep.dev/122",
+	Precondition: preconditionNonExistentReference,
+	Run:          testNonExistentReference,
+	Teardown:     teardownDeleteAllResources,
+}
+
+func preconditionNonExistentReference(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Create == nil {
+		return fmt.Errorf("resource does not support create")
+	}
+	if len(utils.ReferenceFields(ctx.Resource)) == 0 {
+		return fmt.Errorf("resource has no resource reference fields")
+	}
+	return nil
+}
+
+// nonExistentPath returns a path of the resource type with every ID replaced
+// by a random one.
+func nonExistentPath(v ValidationActions, r *api.Resource) string {
+	elems := r.PatternElems()
+	segments := make([]string, 0, len(elems))
+	for _, e := range elems {
+		if strings.HasPrefix(e, "{") {
+			e = v.GenerateID()
+		}
+		segments = append(segments, e)
+	}
+	return strings.Join(segments, "/")
+}
+
+func testNonExistentReference(v ValidationActions, ctx *ValidationContext) error {
+	var failures []string
+	for _, field := range utils.ReferenceFields(ctx.Resource) {
+		payload, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+		if err != nil {
+			return fmt.Errorf("failed to generate create payload: %w", err)
+		}
+		payload[field] = nonExistentPath(v, utils.ReferencedResource(ctx.Resource, field))
+
+		resp, err := v.Post(createURL(v, ctx, ctx.CollectionURL), payload)
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		switch resp.StatusCode {
+		case http.StatusBadRequest, http.StatusNotFound:
+			v.Logger().Printf("   Reference in %q to %v rejected as expected.\n", field, payload[field])
+		case http.StatusOK, http.StatusCreated:
+			var created map[string]interface{}
+			if err := json.Unmarshal(body, &created); err == nil {
+				ctx.Resources = append(ctx.Resources, created)
+			}
+			failures = append(failures, fmt.Sprintf("%s: create succeeded with a reference to %v", field, payload[field]))
+		default:
+			failures = append(failures, fmt.Sprintf("%s: expected 400 or 404, got %d: %s", field, resp.StatusCode, string(body)))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("references to nonexistent resources not rejected:\n%s", strings.Join(failures, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+)
+
+var TestAEP122ParentIsolation = Test{
+	Name:         "aep-122-parent-isolation",
+	URL:          "https://aep.dev/122",
+	Precondition: preconditionParentIsolation,
+	Setup:        setupSiblingParents,
+	Run:          testParentIsolation,
+	Teardown:     teardownSiblingParents,
+}
+
+func preconditionParentIsolation(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Create == nil {
+		return fmt.Errorf("resource does not support create")
+	}
+	if len(listableChildren(ctx.Resource)) == 0 {
+		return fmt.Errorf("resource has no child resources that can be created and listed")
+	}
+	return nil
+}
+
+func testParentIsolation(v ValidationActions, ctx *ValidationContext) error {
+	parentA, parentB := siblingParents(ctx)
+	for _, child := range listableChildren(ctx.Resource) {
+		// Step 1: Listing under each parent only returns its own children.
+		for _, parent := range []map[string]interface{}{parentA, parentB} {
+			if err := checkChildList(v, ctx, parent, child); err != nil {
+				return err
+			}
+		}
+		v.Logger().Printf("   %s lists are isolated by parent.\n", child.Plural)
+
+		// Step 2: A child can not be reached through the wrong parent.
+		childB := childOf(ctx, parentB, child)
+		elems := strings.Split(resourceName(childB), "/")
+		wrongURL := fmt.Sprintf("%s/%s", childCollectionURL(ctx, parentA, child), elems[len(elems)-1])
+
+		resp, err := v.GetReq(wrongURL)
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		if err := expectStatus(resp, http.StatusNotFound); err != nil {
+			return fmt.Errorf("get %s through the wrong parent: %w", resourceName(childB), err)
+		}
+		if child.Methods.Update != nil {
+			resp, err := v.Patch(wrongURL, map[string]interface{}{})
+			if err != nil {
+				return fmt.Errorf("failed to make request: %w", err)
+			}
+			if err := expectStatus(resp, http.StatusNotFound); err != nil {
+				return fmt.Errorf("update %s through the wrong parent: %w", resourceName(childB), err)
+			}
+		}
+		if child.Methods.Delete != nil {
+			resp, err := v.DeleteReq(wrongURL)
+			if err != nil {
+				return fmt.Errorf("failed to make request: %w", err)
+			}
+			if err := expectStatus(resp, http.StatusNotFound); err != nil {
+				return fmt.Errorf("delete %s through the wrong parent: %w", resourceName(childB), err)
+			}
+		}
+		if _, err := v.Get(resourceURL(ctx, childB)); err != nil {
+			return fmt.Errorf("%s is gone after requests through the wrong parent: %w", resourceName(childB), err)
+		}
+		v.Logger().Printf("   %s not reachable through the wrong parent.\n", resourceName(childB))
+	}
+	return nil
+}
+
+// checkChildList lists a child collection under parent and verifies that it
+// holds the parent's child and nothing from other parents.
+func checkChildList(v ValidationActions, ctx *ValidationContext, parent map[string]interface{}, child *api.Resource) error {
+	listURL := childCollectionURL(ctx, parent, child)
+	prefix := listURL[len(ctx.Resource.API.ServerURL)+1:] + "/"
+	want := resourceName(childOf(ctx, parent, child))
+	found := false
+	pageToken := ""
+	for {
+		listResp, err := utils.FetchList(v, listURL, pageToken, 0, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %w", listURL, err)
+		}
+		for _, r := range listResp.Resources {
+			name := resourceName(r)
+			if !strings.HasPrefix(name, prefix) {
+				return fmt.Errorf("list of %s returned %s from another parent", listURL, name)
+			}
+			if name == want {
+				found = true
+			}
+		}
+		pageToken = listResp.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("list of %s did not return %s", listURL, want)
+	}
+	return nil
+}
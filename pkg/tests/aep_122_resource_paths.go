@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+)
+
+var TestAEP122ResourcePaths = Test{
+	Name:     "aep-122-resource-paths",
+	URL:      "https://aep.dev/122",
+	Setup:    setupResourcePaths,
+	Run:      testResourcePaths,
+	Teardown: teardownDeleteAllResources,
+}
+
+// setupResourcePaths makes sure at least one create, get and list response has
+// been observed for the collection under test.
+func setupResourcePaths(v ValidationActions, ctx *ValidationContext) error {
+	if len(ctx.Resources) == 0 {
+		if err := testCreateResource(v, ctx); err != nil {
+			return err
+		}
+	}
+	if ctx.Resource.Methods.Get != nil {
+		if _, err := v.Get(resourceURL(ctx, ctx.Resources[0])); err != nil {
+			return fmt.Errorf("failed to get resource: %w", err)
+		}
+	}
+	if ctx.Resource.Methods.List != nil {
+		if _, err := utils.FetchList(v, ctx.CollectionURL, "", 0, nil); err != nil {
+			return fmt.Errorf("failed to list resources: %w", err)
+		}
+	}
+	return nil
+}
+
+func testResourcePaths(v ValidationActions, ctx *ValidationContext) error {
+	collectionPath := strings.TrimPrefix(ctx.CollectionURL, ctx.Resource.API.ServerURL+"/")
+	var mismatches []string
+	checked := 0
+	for _, o := range v.ObservedPaths() {
+		// Only responses from the collection under test hold resources of this
+		// type. Custom methods may return anything.
+		requestPath := strings.SplitN(strings.TrimPrefix(o.URL, ctx.Resource.API.ServerURL+"/"), "?", 2)[0]
+		inCollection := requestPath == collectionPath || strings.HasPrefix(requestPath, collectionPath+"/")
+		if !inCollection || strings.Contains(requestPath, ":") {
+			continue
+		}
+		if strings.Count(requestPath, "/") > strings.Count(collectionPath, "/")+1 {
+			continue // a child collection
+		}
+		checked++
+		switch {
+		case !utils.MatchesPattern(o.Path, ctx.Resource.PatternElems()):
+			mismatches = append(mismatches, fmt.Sprintf("%s %s: path %q does not match pattern %q\n  response: %s", o.Method, o.URL, o.Path, ctx.Resource.GetPattern(), o.Response))
+		case !strings.HasPrefix(o.Path, collectionPath+"/"):
+			mismatches = append(mismatches, fmt.Sprintf("%s %s: path %q is not under parent %q\n  response: %s", o.Method, o.URL, o.Path, collectionPath, o.Response))
+		}
+	}
+	if len(mismatches) > 0 {
+		return fmt.Errorf("resource paths do not conform to the pattern:\n%s", strings.Join(mismatches, "\n"))
+	}
+	v.Logger().Printf("   Checked %d resource paths.\n", checked)
+	return nil
+}
@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var TestAEP126EnumValues = Test{
+	Name:         "aep-126-enum-values",
+	URL:          "https://aep.dev/126",
+	Precondition: preconditionEnumValues,
+	Run:          testEnumValues,
+	Teardown:     teardownDeleteAllResources,
+}
+
+func preconditionEnumValues(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Create == nil {
+		return fmt.Errorf("resource does not support create")
+	}
+	if len(enumFields(ctx)) == 0 {
+		return fmt.Errorf("resource has no enum fields")
+	}
+	return nil
+}
+
+// enumFields returns the enum fields that a client can set on create.
+func enumFields(ctx *ValidationContext) []string {
+	var fields []string
+	for _, f := range ctx.Generator.Enums.Fields(ctx.Resource.Schema) {
+		if prop, ok := ctx.Resource.Schema.Properties[f]; ok && !prop.ReadOnly {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// undeclaredEnumValue returns a value of the same type as the declared values
+// of an enum that is not one of them.
+func undeclaredEnumValue(declared []interface{}) interface{} {
+	if len(declared) > 0 {
+		if _, numeric := declared[0].(float64); numeric {
+			max := 0.0
+			for _, d := range declared {
+				if n, ok := d.(float64); ok && n > max {
+					max = n
+				}
+			}
+			return max + 1
+		}
+	}
+	value := "UNDECLARED_ENUM_VALUE"
+	for containsValue(declared, value) {
+		value += "_"
+	}
+	return value
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func testEnumValues(v ValidationActions, ctx *ValidationContext) error {
+	var failures []string
+	for _, field := range enumFields(ctx) {
+		declared := ctx.Generator.Enums.Values(ctx.Resource.Schema, field)
+
+		// Step 1: Every declared value round trips through create and get.
+		accepted := true
+		for _, value := range declared {
+			if err := createWithEnumValue(v, ctx, field, value); err != nil {
+				failures = append(failures, fmt.Sprintf("%s=%v: %v", field, value, err))
+				accepted = false
+			}
+		}
+		if accepted {
+			v.Logger().Printf("   Declared values of %q accepted.\n", field)
+		}
+
+		// Step 2: An undeclared value is rejected.
+		payload, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+		if err != nil {
+			return fmt.Errorf("failed to generate create payload: %w", err)
+		}
+		payload[field] = undeclaredEnumValue(declared)
+		resp, err := v.Post(createURL(v, ctx, ctx.CollectionURL), payload)
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		switch resp.StatusCode {
+		case http.StatusBadRequest:
+			v.Logger().Printf("   Undeclared value of %q rejected as expected.\n", field)
+		case http.StatusOK, http.StatusCreated:
+			var created map[string]interface{}
+			if err := json.Unmarshal(body, &created); err == nil {
+				if created, err = awaitResult(v, createIsLongRunning(ctx), created); err == nil {
+					ctx.Resources = append(ctx.Resources, created)
+				}
+			}
+			failures = append(failures, fmt.Sprintf("%s: create succeeded with an undeclared value", field))
+		default:
+			failures = append(failures, fmt.Sprintf("%s: expected 400 for an undeclared value, got %d: %s", field, resp.StatusCode, string(body)))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("enum values not enforced:\n%s", strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+// createWithEnumValue creates a resource with field set to value and verifies
+// the value is returned by create and a subsequent get.
+func createWithEnumValue(v ValidationActions, ctx *ValidationContext, field string, value interface{}) error {
+	payload, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to generate create payload: %w", err)
+	}
+	payload[field] = value
+	resp, err := v.Post(createURL(v, ctx, ctx.CollectionURL), payload)
+	status, created, err := readCreated(v, ctx, resp, err)
+	if err != nil {
+		return err
+	}
+	if created == nil {
+		return fmt.Errorf("create returned %d, expected 200", status)
+	}
+	ctx.Resources = append(ctx.Resources, created)
+
+	want := map[string]interface{}{field: value}
+	if diffs := diffFields(want, created); len(diffs) > 0 {
+		return fmt.Errorf("create response returned %v", created[field])
+	}
+	fetched, err := v.Get(resourceURL(ctx, created))
+	if err != nil {
+		return fmt.Errorf("failed to get resource: %w", err)
+	}
+	if diffs := diffFields(want, fetched); len(diffs) > 0 {
+		return fmt.Errorf("get returned %v", fetched[field])
+	}
+	return nil
+}
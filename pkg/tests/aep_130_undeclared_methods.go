@@ -0,0 +1,147 @@
+package tests
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var TestAEP130UndeclaredMethods = Test{
+	Name:         "aep-130-undeclared-methods",
+	URL:          "https://aep.dev/130",
+	Precondition: preconditionUndeclaredMethods,
+	Setup:        setupUndeclaredMethods,
+	Run:          testUndeclaredMethods,
+	Teardown:     teardownUndeclaredMethods,
+}
+
+// undeclaredMethod is a standard method the resource does not declare, along
+// with the HTTP method it would use and whether it targets the resource or the
+// collection URL.
+type undeclaredMethod struct {
+	name       string
+	httpMethod string
+	onResource bool
+}
+
+// undeclaredMethods returns the standard methods missing from the resource.
+// aep-lib-go does not populate apply from OpenAPI, so it is checked in the
+// spec.
+func undeclaredMethods(ctx *ValidationContext) []undeclaredMethod {
+	m := ctx.Resource.Methods
+	var missing []undeclaredMethod
+	if m.List == nil {
+		missing = append(missing, undeclaredMethod{"list", http.MethodGet, false})
+	}
+	if m.Create == nil {
+		missing = append(missing, undeclaredMethod{"create", http.MethodPost, false})
+	}
+	if m.Get == nil {
+		missing = append(missing, undeclaredMethod{"get", http.MethodGet, true})
+	}
+	if m.Update == nil {
+		missing = append(missing, undeclaredMethod{"update", http.MethodPatch, true})
+	}
+	if !supportsApply(ctx) {
+		missing = append(missing, undeclaredMethod{"apply", http.MethodPut, true})
+	}
+	if m.Delete == nil {
+		missing = append(missing, undeclaredMethod{"delete", http.MethodDelete, true})
+	}
+	return missing
+}
+
+func preconditionUndeclaredMethods(ctx *ValidationContext) error {
+	missing := undeclaredMethods(ctx)
+	if len(missing) == 0 {
+		return fmt.Errorf("resource declares every standard method")
+	}
+	if ctx.Resource.Methods.Create != nil {
+		return nil
+	}
+	for _, m := range missing {
+		if !m.onResource {
+			return nil
+		}
+	}
+	return fmt.Errorf("only resource methods are undeclared, and no resource can be created to target")
+}
+
+func setupUndeclaredMethods(v ValidationActions, ctx *ValidationContext) error {
+	if len(ctx.Resources) == 0 && ctx.Resource.Methods.Create != nil {
+		return testCreateResource(v, ctx)
+	}
+	return nil
+}
+
+// teardownUndeclaredMethods deletes the created resource, unless delete is
+// one of the undeclared methods.
+func teardownUndeclaredMethods(v ValidationActions, ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Delete == nil {
+		return nil
+	}
+	return teardownDeleteAllResources(v, ctx)
+}
+
+func testUndeclaredMethods(v ValidationActions, ctx *ValidationContext) error {
+	var failures []string
+	for _, m := range undeclaredMethods(ctx) {
+		target := ctx.CollectionURL
+		if m.onResource {
+			// Without create there is no resource to target, and a missing
+			// resource may be reported with 404 before the method is checked.
+			if len(ctx.Resources) == 0 {
+				v.Logger().Printf("   No resource exists, skipping undeclared %s.\n", m.name)
+				continue
+			}
+			target = resourceURL(ctx, ctx.Resources[0])
+		}
+		resp, err := sendMethod(v, m.httpMethod, target)
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusMethodNotAllowed {
+			failures = append(failures, fmt.Sprintf("%s (%s): expected 405, got %d: %s", m.name, m.httpMethod, resp.StatusCode, string(body)))
+			continue
+		}
+		allow := resp.Header.Get("Allow")
+		if allow == "" {
+			failures = append(failures, fmt.Sprintf("%s (%s): 405 response is missing an Allow header", m.name, m.httpMethod))
+			continue
+		}
+		for _, a := range strings.Split(allow, ",") {
+			if strings.EqualFold(strings.TrimSpace(a), m.httpMethod) {
+				failures = append(failures, fmt.Sprintf("%s (%s): Allow header %q lists the rejected method", m.name, m.httpMethod, allow))
+				break
+			}
+		}
+		v.Logger().Printf("   Undeclared %s rejected with 405 (Allow: %s).\n", m.name, allow)
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("undeclared methods not rejected with 405:\n%s", strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+// sendMethod sends a request with the given HTTP method and an empty JSON body
+// where one is expected.
+func sendMethod(v ValidationActions, method string, target string) (*http.Response, error) {
+	body := map[string]interface{}{}
+	switch method {
+	case http.MethodGet:
+		return v.GetReq(target)
+	case http.MethodPost:
+		return v.Post(target, body)
+	case http.MethodPatch:
+		return v.Patch(target, body)
+	case http.MethodPut:
+		return v.Put(target, body)
+	case http.MethodDelete:
+		return v.DeleteReq(target)
+	}
+	return nil, fmt.Errorf("unsupported method %s", method)
+}
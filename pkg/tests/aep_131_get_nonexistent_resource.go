@@ -0,0 +1,34 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+var TestAEP131GetNonExistentResource = Test{
+	Name:         "aep-131-get-nonexistent-resource",
+	URL:          "https://aep.dev/131",
+	Precondition: preconditionGetNonExistentResource,
+	Run:          testGetNonExistentResource,
+}
+
+func preconditionGetNonExistentResource(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Get == nil {
+		return fmt.Errorf("resource does not support get")
+	}
+	return nil
+}
+
+func testGetNonExistentResource(v ValidationActions, ctx *ValidationContext) error {
+	rURL := fmt.Sprintf("%s/%s", ctx.CollectionURL, v.GenerateID())
+
+	resp, err := v.GetReq(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	if err := expectErrorResponse(resp, http.StatusNotFound); err != nil {
+		return err
+	}
+	v.Logger().Println("   Got 404 as expected.")
+	return nil
+}
@@ -0,0 +1,170 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+)
+
+var TestAEP132ListOrderBy = Test{
+	Name:         "aep-132-list-order-by",
+	URL:          "https://aep.dev/132",
+	Precondition: preconditionListOrderBy,
+	Setup:        setupListOrderBy,
+	Run:          testListOrderBy,
+	Teardown:     teardownDeleteAllResources,
+}
+
+// orderByParam returns the name of the ordering parameter accepted by the list
+// method, or "" if none.
+func orderByParam(ctx *ValidationContext) string {
+	op := utils.FindOperation(ctx.Spec, utils.CollectionPath(ctx.Resource), "GET")
+	return utils.FindQueryParam(op, "order_by", "orderBy")
+}
+
+// orderByField returns a settable string or integer field to order by, or ""
+// if there is none. Enums and resource references are skipped, as their
+// generated values may not be distinct.
+func orderByField(ctx *ValidationContext) string {
+	for _, f := range mutableFields(ctx) {
+		if len(ctx.Generator.Enums.Values(ctx.Resource.Schema, f)) > 0 || utils.IsReference(ctx.Resource.Schema.Properties[f]) {
+			continue
+		}
+		switch ctx.Resource.Schema.Properties[f].Type {
+		case "string", "integer":
+			return f
+		}
+	}
+	return ""
+}
+
+func preconditionListOrderBy(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.List == nil || ctx.Resource.Methods.Create == nil {
+		return fmt.Errorf("resource does not support list and create")
+	}
+	if orderByParam(ctx) == "" {
+		return fmt.Errorf("list does not accept order_by")
+	}
+	if orderByField(ctx) == "" {
+		return fmt.Errorf("resource has no string or integer field to order by")
+	}
+	return nil
+}
+
+// setupListOrderBy creates resources with distinct values of the ordering
+// field, out of order.
+func setupListOrderBy(v ValidationActions, ctx *ValidationContext) error {
+	field := orderByField(ctx)
+	for _, i := range []int{2, 0, 1} {
+		payload, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+		if err != nil {
+			return fmt.Errorf("failed to generate create payload: %w", err)
+		}
+		if ctx.Resource.Schema.Properties[field].Type == "integer" {
+			payload[field] = 10 * (i + 1)
+		} else {
+			payload[field] = fmt.Sprintf("order-by-%d", i)
+		}
+		resource, err := v.CreateResource(ctx.Resource, ctx.CollectionURL, payload)
+		if err != nil {
+			return err
+		}
+		ctx.Resources = append(ctx.Resources, resource)
+	}
+	return nil
+}
+
+func testListOrderBy(v ValidationActions, ctx *ValidationContext) error {
+	param := orderByParam(ctx)
+	field := orderByField(ctx)
+	sorted := append([]map[string]interface{}{}, ctx.Resources[len(ctx.Resources)-3:]...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return lessValue(sorted[i][field], sorted[j][field])
+	})
+	var want []string
+	for _, r := range sorted {
+		want = append(want, resourceName(r))
+	}
+
+	// Step 1: Ascending order is kept across pages of size 1.
+	got, err := listOrderedNames(v, ctx, url.Values{param: {field}}, want)
+	if err != nil {
+		return err
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		return fmt.Errorf("%s=%s returned %v, want %v", param, field, got, want)
+	}
+	v.Logger().Printf("   Listed in ascending order of %q.\n", field)
+
+	// Step 2: "desc" reverses the order.
+	desc := field + " desc"
+	got, err = listOrderedNames(v, ctx, url.Values{param: {desc}}, want)
+	if err != nil {
+		return err
+	}
+	var reversed []string
+	for i := len(want) - 1; i >= 0; i-- {
+		reversed = append(reversed, want[i])
+	}
+	if strings.Join(got, ",") != strings.Join(reversed, ",") {
+		return fmt.Errorf("%s=%s returned %v, want %v", param, desc, got, reversed)
+	}
+	v.Logger().Printf("   Listed in descending order of %q.\n", field)
+
+	// Step 3: An unknown field is rejected.
+	resp, err := v.GetReq(withQuery(ctx.CollectionURL, param, "nonexistent_field"))
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	if err := expectStatus(resp, http.StatusBadRequest); err != nil {
+		return fmt.Errorf("list ordered by an unknown field: %w", err)
+	}
+	v.Logger().Println("   Unknown order_by field rejected.")
+	return nil
+}
+
+// lessValue orders two decoded JSON strings or numbers.
+func lessValue(a, b interface{}) bool {
+	if x, ok := a.(float64); ok {
+		y, _ := b.(float64)
+		return x < y
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+// listOrderedNames pages through the collection one resource at a time with
+// the given query and returns the names in the order they were listed,
+// keeping only those in names. It fails if a resource is listed twice.
+func listOrderedNames(v ValidationActions, ctx *ValidationContext, query url.Values, names []string) ([]string, error) {
+	wanted := make(map[string]bool)
+	for _, n := range names {
+		wanted[n] = true
+	}
+	seen := make(map[string]bool)
+	var got []string
+	pageToken := ""
+	for {
+		listResp, err := utils.FetchList(v, ctx.CollectionURL, pageToken, 1, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resources with %s: %w", query.Encode(), err)
+		}
+		for _, r := range listResp.Resources {
+			name := resourceName(r)
+			if seen[name] {
+				return nil, fmt.Errorf("%s listed twice with %s", name, query.Encode())
+			}
+			seen[name] = true
+			if wanted[name] {
+				got = append(got, name)
+			}
+		}
+		pageToken = listResp.NextPageToken
+		if pageToken == "" {
+			return got, nil
+		}
+	}
+}
@@ -15,7 +15,7 @@ var TestAEP132ListResourcesLimit1 = Test{
 }
 
 func testListResourcesLimit1(v ValidationActions, ctx *ValidationContext) error {
-	listResp, err := utils.FetchList(v, ctx.CollectionURL, "", 1)
+	listResp, err := utils.FetchList(v, ctx.CollectionURL, "", 1, nil)
 	if err != nil {
 		return err
 	}
@@ -16,7 +16,7 @@ var TestAEP132ListResourcesPageToken = Test{
 
 func testListResourcesPageToken(v ValidationActions, ctx *ValidationContext) error {
 	// Step 1: List with limit 1 to get a page token
-	listResp1, err := utils.FetchList(v, ctx.CollectionURL, "", 1)
+	listResp1, err := utils.FetchList(v, ctx.CollectionURL, "", 1, nil)
 	if err != nil {
 		return err
 	}
@@ -25,7 +25,7 @@ func testListResourcesPageToken(v ValidationActions, ctx *ValidationContext) err
 	}
 
 	// Step 2: List using the page token
-	listResp2, err := utils.FetchList(v, ctx.CollectionURL, listResp1.NextPageToken, 1)
+	listResp2, err := utils.FetchList(v, ctx.CollectionURL, listResp1.NextPageToken, 1, nil)
 	if err != nil {
 		return err
 	}
@@ -12,7 +12,7 @@ var TestAEP133Create = Test{
 }
 
 func testCreateResource(v ValidationActions, ctx *ValidationContext) error {
-	resource, err := utils.CreateResource(v, ctx.Resource, ctx.CollectionURL)
+	resource, err := utils.CreateResource(v, ctx.Generator, ctx.Resource, ctx.CollectionURL)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var TestAEP133CreateNonExistentParent = Test{
+	Name:         "aep-133-create-nonexistent-parent",
+	URL:          "https://aep.dev/133",
+	Precondition: preconditionCreateNonExistentParent,
+	Run:          testCreateNonExistentParent,
+	Teardown:     teardownDeleteAllResources,
+}
+
+func preconditionCreateNonExistentParent(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Create == nil {
+		return fmt.Errorf("resource does not support create")
+	}
+	if len(ctx.Resource.Parents) == 0 {
+		return fmt.Errorf("resource has no parent")
+	}
+	return nil
+}
+
+// nonExistentCollectionURL returns the URL of the resource's collection with
+// every parent ID replaced by a random one.
+func nonExistentCollectionURL(v ValidationActions, ctx *ValidationContext) string {
+	elems := ctx.Resource.PatternElems()
+	segments := make([]string, 0, len(elems)-1)
+	for _, e := range elems[:len(elems)-1] {
+		if strings.HasPrefix(e, "{") {
+			e = v.GenerateID()
+		}
+		segments = append(segments, e)
+	}
+	return fmt.Sprintf("%s/%s", ctx.Resource.API.ServerURL, strings.Join(segments, "/"))
+}
+
+func testCreateNonExistentParent(v ValidationActions, ctx *ValidationContext) error {
+	payload, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to generate create payload: %w", err)
+	}
+
+	resp, err := v.Post(createURL(v, ctx, nonExistentCollectionURL(v, ctx)), payload)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		var created map[string]interface{}
+		if err := json.Unmarshal(body, &created); err == nil {
+			ctx.Resources = append(ctx.Resources, created)
+		}
+		return fmt.Errorf("create under a nonexistent parent succeeded (status %d), expected 404", resp.StatusCode)
+	}
+	if err := expectErrorResponse(resp, http.StatusNotFound); err != nil {
+		return err
+	}
+	v.Logger().Println("   Got 404 as expected.")
+	return nil
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -25,28 +26,41 @@ func setupDuplicateCreationCheck(v ValidationActions, ctx *ValidationContext) er
 
 func testDuplicateCreationCheck(v ValidationActions, ctx *ValidationContext) error {
 	r := ctx.Resource
-	if r.Methods.Create != nil && r.Methods.Create.SupportsUserSettableCreate {
-		v.Logger().Println("   Attempting duplicate creation...")
-		r1Name, ok := ctx.Resources[0]["name"].(string)
-		if !ok || r1Name == "" {
-			r1Name, _ = ctx.Resources[0]["path"].(string)
-		}
-		r1ID := getIDFromResourceName(r1Name)
-		createPayload, _ := utils.GenerateCreatePayload(r)
-
-		urlWithID := fmt.Sprintf("%s?id=%s", ctx.CollectionURL, r1ID)
-		resp, err := v.Post(urlWithID, createPayload)
-		if err != nil {
-			return fmt.Errorf("failed to make request: %w", err)
-		}
-		defer resp.Body.Close()
-		if resp.StatusCode != http.StatusConflict && resp.StatusCode != http.StatusBadRequest {
-			return fmt.Errorf("expected 409/400 for duplicate creation, got %d", resp.StatusCode)
-		}
-		v.Logger().Println("   Duplicate creation rejected as expected.")
-	} else {
+	idParam := utils.CreateIDParam(ctx.Spec, r)
+	if idParam == "" {
 		v.Logger().Println("   Skipping duplicate check (client-assigned ID not supported or uncheckable).")
+		return nil
 	}
+
+	v.Logger().Println("   Attempting duplicate creation...")
+	original := ctx.Resources[0]
+	r1ID := getIDFromResourceName(resourceName(original))
+	createPayload, err := ctx.Generator.GenerateCreatePayload(r)
+	if err != nil {
+		return fmt.Errorf("failed to generate create payload: %w", err)
+	}
+
+	urlWithID := fmt.Sprintf("%s?%s=%s", ctx.CollectionURL, idParam, r1ID)
+	resp, err := v.Post(urlWithID, createPayload)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusConflict {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("expected 409 ALREADY_EXISTS for duplicate creation, got %d: %s", resp.StatusCode, string(body))
+	}
+	v.Logger().Println("   Duplicate creation rejected as expected.")
+
+	// The rejected request must not have overwritten the original resource.
+	current, err := v.Get(resourceURL(ctx, original))
+	if err != nil {
+		return fmt.Errorf("failed to get original resource after duplicate creation: %w", err)
+	}
+	if diffs := diffFields(original, current); len(diffs) > 0 {
+		return fmt.Errorf("original resource was modified by duplicate creation (fields: %s)", strings.Join(diffs, ", "))
+	}
+	v.Logger().Println("   Original resource unchanged.")
 	return nil
 }
 
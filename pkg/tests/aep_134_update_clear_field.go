@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"fmt"
+)
+
+var TestAEP134UpdateClearField = Test{
+	Name:         "aep-134-update-clear-field",
+	URL:          "https://aep.dev/134",
+	Precondition: preconditionUpdateClearField,
+	Setup:        setupUpdateResource,
+	Run:          testUpdateClearField,
+	Teardown:     testDeleteResource,
+}
+
+func preconditionUpdateClearField(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Update == nil {
+		return fmt.Errorf("resource does not support update")
+	}
+	if len(optionalMutableFields(ctx)) == 0 {
+		return fmt.Errorf("resource has no optional mutable fields")
+	}
+	return nil
+}
+
+func testUpdateClearField(v ValidationActions, ctx *ValidationContext) error {
+	rURL := resourceURL(ctx, ctx.Resources[0])
+	current, err := v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to get resource before update: %w", err)
+	}
+	field := clearableField(ctx, current)
+	if field == "" {
+		return fmt.Errorf("none of the optional fields %v set on create are populated on the resource", optionalMutableFields(ctx))
+	}
+
+	if _, err := patchResource(v, updateIsLongRunning(ctx), rURL, map[string]interface{}{field: nil}); err != nil {
+		return err
+	}
+	cleared, err := v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to get resource after clearing %q: %w", field, err)
+	}
+	if !isZeroValue(cleared[field]) {
+		return fmt.Errorf("expected %q to be cleared by null, got %v", field, cleared[field])
+	}
+	v.Logger().Printf("   Field %q cleared by null.\n", field)
+	return nil
+}
@@ -0,0 +1,153 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+)
+
+var TestAEP134UpdateMask = Test{
+	Name:         "aep-134-update-mask",
+	URL:          "https://aep.dev/134",
+	Precondition: preconditionUpdateMask,
+	Setup:        setupUpdateResource,
+	Run:          testUpdateMask,
+	Teardown:     testDeleteResource,
+}
+
+// updateMaskParam returns the name of the field mask parameter declared by the
+// update operation, or "" if the update method does not accept one.
+func updateMaskParam(ctx *ValidationContext) string {
+	op := utils.FindOperation(ctx.Spec, utils.ResourcePath(ctx.Resource), "PATCH")
+	return utils.FindQueryParam(op, "update_mask", "updateMask")
+}
+
+func preconditionUpdateMask(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Update == nil {
+		return fmt.Errorf("resource does not support update")
+	}
+	if updateMaskParam(ctx) == "" {
+		return fmt.Errorf("update method does not accept an update mask")
+	}
+	if len(mutableFields(ctx)) < 2 {
+		return fmt.Errorf("resource needs at least 2 mutable fields to test update masks")
+	}
+	return nil
+}
+
+func testUpdateMask(v ValidationActions, ctx *ValidationContext) error {
+	maskParam := updateMaskParam(ctx)
+	rURL := resourceURL(ctx, ctx.Resources[0])
+	fields := mutableFields(ctx)
+
+	// Step 1: Change every field, but only name one in the mask.
+	original, err := v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to get resource before update: %w", err)
+	}
+	body, err := changedValues(ctx, fields, original)
+	if err != nil {
+		return err
+	}
+	masked := fields[0]
+	if _, err := patchResource(v, updateIsLongRunning(ctx), withQuery(rURL, maskParam, masked), body); err != nil {
+		return err
+	}
+	fetched, err := v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to get resource after update: %w", err)
+	}
+	if diffs := diffFields(map[string]interface{}{masked: body[masked]}, fetched); len(diffs) > 0 {
+		return fmt.Errorf("field %q named in the update mask was not updated", masked)
+	}
+	unmasked := make(map[string]interface{})
+	for _, f := range fields[1:] {
+		unmasked[f] = original[f]
+	}
+	if diffs := diffFields(unmasked, fetched); len(diffs) > 0 {
+		return fmt.Errorf("fields not named in the update mask were modified: %s", strings.Join(diffs, ", "))
+	}
+	v.Logger().Printf("   Only %q was updated.\n", masked)
+
+	// Step 2: An unknown field path in the mask is rejected.
+	resp, err := v.Patch(withQuery(rURL, maskParam, "nonexistent_field_path"), map[string]interface{}{})
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	if err := expectStatus(resp, http.StatusBadRequest); err != nil {
+		return fmt.Errorf("invalid update mask: %w", err)
+	}
+	v.Logger().Println("   Invalid update mask rejected.")
+
+	// Step 3: A "*" mask replaces the resource, clearing omitted fields.
+	current, err := v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to get resource before full replacement: %w", err)
+	}
+	omitted := clearableField(ctx, current)
+	if body, err = replacementBody(ctx, current, omitted); err != nil {
+		return err
+	}
+	if _, err := patchResource(v, updateIsLongRunning(ctx), withQuery(rURL, maskParam, "*"), body); err != nil {
+		return err
+	}
+	fetched, err = v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to get resource after full replacement: %w", err)
+	}
+	if diffs := diffFields(body, fetched); len(diffs) > 0 {
+		return fmt.Errorf("fields were not replaced with a \"*\" mask: %s", strings.Join(diffs, ", "))
+	}
+	if omitted != "" && !isZeroValue(fetched[omitted]) {
+		return fmt.Errorf("expected %q omitted from a \"*\" update to be cleared, got %v", omitted, fetched[omitted])
+	}
+	v.Logger().Println("   \"*\" update mask replaced the resource.")
+	return nil
+}
+
+// replacementBody returns a full replacement of the resource: every writable
+// field of the resource, with the mutable fields changed and omitted left out
+// so that the replacement clears it. Fields that can not be changed, such as
+// immutable fields and references, keep their current values.
+func replacementBody(ctx *ValidationContext, resource map[string]interface{}, omitted string) (map[string]interface{}, error) {
+	var changed []string
+	for _, f := range mutableFields(ctx) {
+		if f != omitted {
+			changed = append(changed, f)
+		}
+	}
+	body, err := changedValues(ctx, changed, resource)
+	if err != nil {
+		return nil, err
+	}
+	for name, prop := range ctx.Resource.Schema.Properties {
+		if _, ok := body[name]; ok || prop.ReadOnly || name == omitted || isVolatileField(name) {
+			continue
+		}
+		if val, ok := resource[name]; ok {
+			body[name] = val
+		}
+	}
+	for _, name := range ctx.Resource.Schema.Required {
+		if _, ok := body[name]; !ok && !ctx.Resource.Schema.Properties[name].ReadOnly {
+			return nil, fmt.Errorf("resource is missing required field %q", name)
+		}
+	}
+	return body, nil
+}
+
+// changedValues returns new values for each of the fields that differ from
+// those on the resource.
+func changedValues(ctx *ValidationContext, fields []string, resource map[string]interface{}) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	for _, f := range fields {
+		value, err := changedValue(ctx, f, resource[f])
+		if err != nil {
+			return nil, err
+		}
+		values[f] = value
+	}
+	return values, nil
+}
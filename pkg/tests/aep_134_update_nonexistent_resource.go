@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+var TestAEP134UpdateNonExistentResource = Test{
+	Name:         "aep-134-update-nonexistent-resource",
+	URL:          "https://aep.dev/134",
+	Precondition: preconditionUpdateNonExistentResource,
+	Run:          testUpdateNonExistentResource,
+}
+
+func preconditionUpdateNonExistentResource(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Update == nil {
+		return fmt.Errorf("resource does not support update")
+	}
+	return nil
+}
+
+func testUpdateNonExistentResource(v ValidationActions, ctx *ValidationContext) error {
+	rURL := fmt.Sprintf("%s/%s", ctx.CollectionURL, v.GenerateID())
+	updatePayload, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to generate update payload: %w", err)
+	}
+
+	resp, err := v.Patch(rURL, updatePayload)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		// The server upserted the resource, clean it up before failing.
+		resp.Body.Close()
+		_ = v.Delete(rURL)
+		return fmt.Errorf("update of a nonexistent resource created it (status %d), expected 404", resp.StatusCode)
+	}
+	if err := expectErrorResponse(resp, http.StatusNotFound); err != nil {
+		return err
+	}
+	v.Logger().Println("   Got 404 as expected.")
+	return nil
+}
@@ -1,9 +1,12 @@
 package tests
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
 )
@@ -24,26 +27,154 @@ func setupUpdateResource(v ValidationActions, ctx *ValidationContext) error {
 }
 
 func testUpdateResource(v ValidationActions, ctx *ValidationContext) error {
-	updatePayload, err := utils.GenerateCreatePayload(ctx.Resource)
+	rURL := resourceURL(ctx, ctx.Resources[0])
+	original, err := v.Get(rURL)
 	if err != nil {
-		return fmt.Errorf("failed to generate update payload: %w", err)
+		return fmt.Errorf("failed to get resource before update: %w", err)
 	}
 
-	rName, ok := ctx.Resources[0]["name"].(string)
-	if !ok || rName == "" {
-		rName, _ = ctx.Resources[0]["path"].(string)
+	// Step 1: Send a partial merge patch changing a single field.
+	fields := mutableFields(ctx)
+	if len(fields) == 0 {
+		v.Logger().Println("   No mutable fields, sending an empty update.")
+	}
+	updatePayload := make(map[string]interface{})
+	if len(fields) > 0 {
+		field := fields[0]
+		value, err := changedValue(ctx, field, original[field])
+		if err != nil {
+			return err
+		}
+		updatePayload[field] = value
 	}
-	rURL := fmt.Sprintf("%s/%s", ctx.Resource.API.ServerURL, rName)
 
-	respUpdate, err := v.Patch(rURL, updatePayload)
+	updated, err := patchResource(v, updateIsLongRunning(ctx), rURL, updatePayload)
 	if err != nil {
 		return err
 	}
-	defer respUpdate.Body.Close()
-	if respUpdate.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(respUpdate.Body)
-		return fmt.Errorf("update returned %d: %s", respUpdate.StatusCode, string(body))
-	}
 	v.Logger().Println("   Update successful.")
+
+	// Step 2: Verify changed fields persisted and untouched fields kept their values.
+	fetched, err := v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to get resource after update: %w", err)
+	}
+	if diffs := diffFields(updatePayload, fetched); len(diffs) > 0 {
+		return fmt.Errorf("updated fields were not persisted: %s", strings.Join(diffs, ", "))
+	}
+	untouched := make(map[string]interface{})
+	for k, val := range original {
+		if _, ok := updatePayload[k]; !ok && !isVolatileField(k) {
+			untouched[k] = val
+		}
+	}
+	if diffs := diffFields(untouched, fetched); len(diffs) > 0 {
+		return fmt.Errorf("fields not in the update were modified: %s", strings.Join(diffs, ", "))
+	}
+
+	// Step 3: Verify the update response matches the persisted resource.
+	if diffs := diffResources(updated, fetched); len(diffs) > 0 {
+		return fmt.Errorf("update response differs from subsequent get (fields: %s)", strings.Join(diffs, ", "))
+	}
+	v.Logger().Println("   Update persisted and matches get.")
 	return nil
 }
+
+// updateIsLongRunning reports whether the update method of the resource
+// returns an operation.
+func updateIsLongRunning(ctx *ValidationContext) bool {
+	update := ctx.Resource.Methods.Update
+	if update != nil && update.IsLongRunning {
+		return true
+	}
+	return utils.IsLongRunning(ctx.Spec, utils.ResourcePath(ctx.Resource), "PATCH")
+}
+
+// patchResource sends a merge patch and returns the decoded resource, failing
+// on any status other than 200. When lro is set, the response is an operation
+// and the resource is its result.
+func patchResource(v ValidationActions, lro bool, rURL string, body map[string]interface{}) (map[string]interface{}, error) {
+	resp, err := v.Patch(rURL, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("update returned %d: %s", resp.StatusCode, string(respBody))
+	}
+	var updated map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		return nil, fmt.Errorf("failed to decode update response: %w", err)
+	}
+	return awaitResult(v, lro, updated)
+}
+
+// mutableFields returns the sorted names of the fields a client can change
+// with an update, and for which changedValue can produce a new value. Resource
+// references are excluded, as a generated value would not refer to an existing
+// resource.
+func mutableFields(ctx *ValidationContext) []string {
+	payload, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+	if err != nil {
+		return nil
+	}
+	immutable := make(map[string]bool)
+	for _, f := range utils.ImmutableFields(ctx.Resource.Schema) {
+		immutable[f] = true
+	}
+	var fields []string
+	for name := range payload {
+		if !immutable[name] && canChangeValue(ctx, ctx.Resource.Schema, name) {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// optionalMutableFields returns the mutable fields that are not required, and
+// so can be cleared.
+func optionalMutableFields(ctx *ValidationContext) []string {
+	required := make(map[string]bool)
+	for _, f := range ctx.Resource.Schema.Required {
+		required[f] = true
+	}
+	var fields []string
+	for _, f := range mutableFields(ctx) {
+		if !required[f] {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// clearableField returns a mutable, optional field that is populated on the
+// resource, or "" if there is none.
+func clearableField(ctx *ValidationContext, resource map[string]interface{}) string {
+	for _, f := range optionalMutableFields(ctx) {
+		if !isZeroValue(resource[f]) {
+			return f
+		}
+	}
+	return ""
+}
+
+// isZeroValue reports whether a decoded JSON value is absent or empty.
+func isZeroValue(value interface{}) bool {
+	switch val := value.(type) {
+	case nil:
+		return true
+	case string:
+		return val == ""
+	case float64:
+		return val == 0
+	case bool:
+		return !val
+	case []interface{}:
+		return len(val) == 0
+	case map[string]interface{}:
+		return len(val) == 0
+	}
+	return false
+}
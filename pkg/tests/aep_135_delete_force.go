@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/constants"
+)
+
+var TestAEP135DeleteForce = Test{
+	Name:         "aep-135-delete-force",
+	URL:          "https://aep.dev/135",
+	Precondition: preconditionDeleteForce,
+	Setup:        setupDeleteForce,
+	Run:          testDeleteForce,
+	Teardown:     teardownDeleteForce,
+}
+
+// supportsForceDelete reports whether the delete operation in the spec accepts
+// a force parameter.
+func supportsForceDelete(ctx *ValidationContext) bool {
+	op := utils.FindOperation(ctx.Spec, utils.ResourcePath(ctx.Resource), "DELETE")
+	return utils.FindQueryParam(op, constants.FIELD_FORCE_NAME) != ""
+}
+
+// creatableChildren returns the distinct child resource types of the resource
+// that support create.
+func creatableChildren(r *api.Resource) []*api.Resource {
+	seen := make(map[string]bool)
+	var children []*api.Resource
+	for _, c := range r.Children {
+		if c == nil || seen[c.Singular] || c.Methods.Create == nil {
+			continue
+		}
+		seen[c.Singular] = true
+		children = append(children, c)
+	}
+	return children
+}
+
+func preconditionDeleteForce(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Delete == nil {
+		return fmt.Errorf("resource does not support delete")
+	}
+	if !supportsForceDelete(ctx) {
+		return fmt.Errorf("delete method does not support force")
+	}
+	if len(creatableChildren(ctx.Resource)) == 0 {
+		return fmt.Errorf("resource has no child resources that can be created")
+	}
+	return nil
+}
+
+func setupDeleteForce(v ValidationActions, ctx *ValidationContext) error {
+	if err := setupDeleteResource(v, ctx); err != nil {
+		return err
+	}
+	for _, child := range creatableChildren(ctx.Resource) {
+		created, err := utils.CreateResource(v, ctx.Generator, child, childCollectionURL(ctx, ctx.Resources[0], child))
+		if err != nil {
+			return fmt.Errorf("failed to create child %s: %w", child.Singular, err)
+		}
+		ctx.Children = append(ctx.Children, created)
+	}
+	return nil
+}
+
+func testDeleteForce(v ValidationActions, ctx *ValidationContext) error {
+	parent := ctx.Resources[0]
+	parentURL := resourceURL(ctx, parent)
+
+	// Step 1: Delete without force must be rejected while children exist.
+	resp, err := v.DeleteReq(parentURL)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNoContent {
+		resp.Body.Close()
+		ctx.Resources = ctx.Resources[1:]
+		return fmt.Errorf("delete of a resource with children succeeded without force (status %d), expected 409/400", resp.StatusCode)
+	}
+	if err := expectStatus(resp, http.StatusConflict, http.StatusBadRequest); err != nil {
+		return fmt.Errorf("delete without force: %w", err)
+	}
+	v.Logger().Println("   Delete without force rejected as expected.")
+
+	// Step 2: Delete with force removes the parent and its children.
+	if err := v.Delete(withQuery(parentURL, constants.FIELD_FORCE_NAME, "true")); err != nil {
+		return fmt.Errorf("delete with force: %w", err)
+	}
+	ctx.Resources = ctx.Resources[1:]
+	v.Logger().Println("   Delete with force successful.")
+
+	if softDeletes(ctx) {
+		ctx.Children = nil
+		v.Logger().Println("   Resource is soft-deleted, skipping get after delete.")
+		return purgeSoftDeleted(v, ctx, parentURL)
+	}
+	for _, r := range append([]map[string]interface{}{parent}, ctx.Children...) {
+		resp, err := v.GetReq(resourceURL(ctx, r))
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		if err := expectStatus(resp, http.StatusNotFound); err != nil {
+			return fmt.Errorf("get %s after forced delete: %w", resourceName(r), err)
+		}
+	}
+	ctx.Children = nil
+	v.Logger().Println("   Parent and children are gone.")
+	return nil
+}
+
+// teardownDeleteForce deletes any remaining children before their parent.
+func teardownDeleteForce(v ValidationActions, ctx *ValidationContext) error {
+	for _, child := range ctx.Children {
+		if err := v.Delete(resourceURL(ctx, child)); err != nil && !strings.Contains(err.Error(), "status 404") {
+			return err
+		}
+	}
+	ctx.Children = nil
+	return testDeleteResource(v, ctx)
+}
@@ -2,13 +2,14 @@ package tests
 
 import (
 	"fmt"
+	"net/http"
 )
 
 var TestAEP135DeleteResource = Test{
 	Name:  "aep-135-delete-resource",
 	URL:   "https://aep.dev/135",
 	Setup: setupDeleteResource,
-	Run:   testDeleteResource,
+	Run:   testDeleteAndVerifyResource,
 }
 
 func setupDeleteResource(v ValidationActions, ctx *ValidationContext) error {
@@ -38,3 +39,41 @@ func testDeleteResource(v ValidationActions, ctx *ValidationContext) error {
 	v.Logger().Println("   Delete successful.")
 	return nil
 }
+
+// testDeleteAndVerifyResource deletes a resource and verifies it can no longer
+// be retrieved or listed. Soft-deleted resources remain retrievable, see
+// aep.dev/164.
+func testDeleteAndVerifyResource(v ValidationActions, ctx *ValidationContext) error {
+	deleted := ctx.Resources[0]
+	if err := testDeleteResource(v, ctx); err != nil {
+		return err
+	}
+
+	if softDeletes(ctx) {
+		v.Logger().Println("   Resource is soft-deleted, skipping get after delete.")
+	} else {
+		resp, err := v.GetReq(resourceURL(ctx, deleted))
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		if err := expectStatus(resp, http.StatusNotFound); err != nil {
+			return fmt.Errorf("get after delete: %w", err)
+		}
+		v.Logger().Println("   Get after delete returned 404.")
+	}
+
+	if ctx.Resource.Methods.List != nil {
+		found, err := listContains(v, ctx, resourceName(deleted))
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("deleted resource %s is still listed", resourceName(deleted))
+		}
+		v.Logger().Println("   Deleted resource is no longer listed.")
+	}
+	if softDeletes(ctx) {
+		return purgeSoftDeleted(v, ctx, resourceURL(ctx, deleted))
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+var TestAEP135DeleteTwice = Test{
+	Name:         "aep-135-delete-twice",
+	URL:          "https://aep.dev/135",
+	Precondition: preconditionDeleteTwice,
+	Setup:        setupDeleteResource,
+	Run:          testDeleteTwice,
+}
+
+// preconditionDeleteTwice skips soft-deleted resources, which still exist after
+// the first delete, see aep.dev/164.
+func preconditionDeleteTwice(ctx *ValidationContext) error {
+	if softDeletes(ctx) {
+		return fmt.Errorf("resource is soft-deleted")
+	}
+	return nil
+}
+
+func testDeleteTwice(v ValidationActions, ctx *ValidationContext) error {
+	rURL := resourceURL(ctx, ctx.Resources[0])
+	if err := testDeleteResource(v, ctx); err != nil {
+		return err
+	}
+
+	resp, err := v.DeleteReq(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	if err := expectStatus(resp, http.StatusNotFound); err != nil {
+		return fmt.Errorf("second delete: %w", err)
+	}
+	v.Logger().Println("   Second delete returned 404 as expected.")
+	return nil
+}
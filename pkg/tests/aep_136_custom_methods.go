@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+)
+
+var TestAEP136CustomMethods = Test{
+	Name:         "aep-136-custom-methods",
+	URL:          "https://aep.dev/136",
+	Precondition: preconditionCustomMethods,
+	Setup:        setupCustomMethods,
+	Run:          testCustomMethods,
+	Teardown:     teardownDeleteAllResources,
+}
+
+// customMethodCall is a custom method along with the URL it is invoked on.
+type customMethodCall struct {
+	method *api.CustomMethod
+	url    string
+}
+
+// skippedCustomMethods are custom methods covered by other tests, which can
+// not be invoked on an arbitrary resource.
+var skippedCustomMethods = map[string]bool{
+	"undelete": true,
+}
+
+// invocableCustomMethods returns the resource-scoped and collection-scoped
+// custom methods to invoke. POST methods may have side effects, so they are
+// only invoked when the user opts in.
+func invocableCustomMethods(ctx *ValidationContext) ([]*api.CustomMethod, []*api.CustomMethod, error) {
+	invocable := func(cm *api.CustomMethod) bool {
+		if skippedCustomMethods[cm.Name] || batchMethodNames[cm.Name] {
+			return false
+		}
+		return cm.Method == "GET" || ctx.InvokeCustomMethods
+	}
+	var resourceMethods, collectionMethods []*api.CustomMethod
+	for _, cm := range ctx.Resource.CustomMethods {
+		if invocable(cm) {
+			resourceMethods = append(resourceMethods, cm)
+		}
+	}
+	declared, err := utils.CollectionCustomMethods(ctx.Spec, ctx.Resource)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, cm := range declared {
+		if invocable(cm) {
+			collectionMethods = append(collectionMethods, cm)
+		}
+	}
+	return resourceMethods, collectionMethods, nil
+}
+
+func preconditionCustomMethods(ctx *ValidationContext) error {
+	resourceMethods, collectionMethods, err := invocableCustomMethods(ctx)
+	if err != nil {
+		return err
+	}
+	if len(resourceMethods) == 0 && len(collectionMethods) == 0 {
+		return fmt.Errorf("resource has no custom methods that can be invoked (POST methods require --invoke-custom-methods)")
+	}
+	if len(resourceMethods) > 0 && ctx.Resource.Methods.Create == nil {
+		return fmt.Errorf("resource does not support create")
+	}
+	return nil
+}
+
+func setupCustomMethods(v ValidationActions, ctx *ValidationContext) error {
+	resourceMethods, _, err := invocableCustomMethods(ctx)
+	if err != nil {
+		return err
+	}
+	if len(resourceMethods) > 0 && len(ctx.Resources) == 0 {
+		return testCreateResource(v, ctx)
+	}
+	return nil
+}
+
+// customMethodCalls returns the custom methods to invoke, with resource-scoped
+// methods bound to the first created resource.
+func customMethodCalls(ctx *ValidationContext) ([]customMethodCall, error) {
+	resourceMethods, collectionMethods, err := invocableCustomMethods(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var calls []customMethodCall
+	for _, cm := range resourceMethods {
+		calls = append(calls, customMethodCall{method: cm, url: fmt.Sprintf("%s:%s", resourceURL(ctx, ctx.Resources[0]), cm.Name)})
+	}
+	for _, cm := range collectionMethods {
+		calls = append(calls, customMethodCall{method: cm, url: fmt.Sprintf("%s:%s", ctx.CollectionURL, cm.Name)})
+	}
+	return calls, nil
+}
+
+func testCustomMethods(v ValidationActions, ctx *ValidationContext) error {
+	calls, err := customMethodCalls(ctx)
+	if err != nil {
+		return err
+	}
+	var failures []string
+	for _, call := range calls {
+		if err := invokeCustomMethod(v, ctx, call); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", call.method.Name, err))
+			continue
+		}
+		v.Logger().Printf("   Custom method %q succeeded.\n", call.method.Name)
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("custom methods failed:\n%s", strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+// invokeCustomMethod calls a custom method with a generated request body and
+// validates the response against the declared response schema.
+func invokeCustomMethod(v ValidationActions, ctx *ValidationContext, call customMethodCall) error {
+	var resp *http.Response
+	var err error
+	switch call.method.Method {
+	case "GET":
+		resp, err = v.GetReq(call.url)
+	default:
+		body := map[string]interface{}{}
+		if call.method.Request != nil {
+			if body, err = ctx.Generator.GeneratePayload(call.method.Request); err != nil {
+				return fmt.Errorf("failed to generate request: %w", err)
+			}
+		}
+		resp, err = v.Post(call.url, body)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected 200, got %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var result interface{}
+	if len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, &result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+	if call.method.IsLongRunning {
+		op, _ := result.(map[string]interface{})
+		if !utils.IsOperation(op) {
+			return fmt.Errorf("expected an operation from long-running method, got %s", string(respBody))
+		}
+		if result, err = v.WaitOperation(op); err != nil {
+			return err
+		}
+	}
+	if errs := utils.ValidateAgainstSchema(result, call.method.Response); len(errs) > 0 {
+		return fmt.Errorf("response does not match schema: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
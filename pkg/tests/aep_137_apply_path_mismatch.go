@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var TestAEP137ApplyPathMismatch = Test{
+	Name:         "aep-137-apply-path-mismatch",
+	URL:          "https://aep.dev/137",
+	Precondition: preconditionApply,
+	Run:          testApplyPathMismatch,
+	Teardown:     teardownDeleteAllResources,
+}
+
+func testApplyPathMismatch(v ValidationActions, ctx *ValidationContext) error {
+	payload, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to generate apply payload: %w", err)
+	}
+	collectionPath := strings.TrimPrefix(ctx.CollectionURL, ctx.Resource.API.ServerURL+"/")
+	payload["path"] = fmt.Sprintf("%s/%s", collectionPath, v.GenerateID()+"-other")
+	rURL := fmt.Sprintf("%s/%s", ctx.CollectionURL, v.GenerateID())
+
+	resp, err := v.Put(rURL, payload)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		resp.Body.Close()
+		_ = v.Delete(rURL)
+		return fmt.Errorf("apply with a body path that disagrees with the URL succeeded (status %d), expected 400", resp.StatusCode)
+	}
+	if err := expectStatus(resp, http.StatusBadRequest); err != nil {
+		return err
+	}
+	v.Logger().Println("   Mismatched path rejected as expected.")
+	return nil
+}
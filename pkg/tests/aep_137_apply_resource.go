@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+)
+
+var TestAEP137ApplyResource = Test{
+	Name:         "aep-137-apply-resource",
+	URL:          "https://aep.dev/137",
+	Precondition: preconditionApply,
+	Run:          testApplyResource,
+	Teardown:     teardownDeleteAllResources,
+}
+
+// supportsApply reports whether the resource declares an apply method, either
+// in the parsed API or as a PUT operation in the spec.
+func supportsApply(ctx *ValidationContext) bool {
+	if ctx.Resource.Methods.Apply != nil {
+		return true
+	}
+	return utils.FindOperation(ctx.Spec, utils.ResourcePath(ctx.Resource), "PUT") != nil
+}
+
+func preconditionApply(ctx *ValidationContext) error {
+	if !supportsApply(ctx) {
+		return fmt.Errorf("resource does not support apply")
+	}
+	return nil
+}
+
+// applyIsLongRunning reports whether the apply method of the resource returns
+// an operation.
+func applyIsLongRunning(ctx *ValidationContext) bool {
+	apply := ctx.Resource.Methods.Apply
+	if apply != nil && apply.IsLongRunning {
+		return true
+	}
+	return utils.IsLongRunning(ctx.Spec, utils.ResourcePath(ctx.Resource), "PUT")
+}
+
+// applyResource sends an apply request and returns the decoded resource,
+// failing on any status other than 200 or 201. When lro is set, the response is
+// an operation and the resource is its result.
+func applyResource(v ValidationActions, lro bool, rURL string, body map[string]interface{}) (map[string]interface{}, error) {
+	resp, err := v.Put(rURL, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("apply returned %d: %s", resp.StatusCode, string(respBody))
+	}
+	var applied map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&applied); err != nil {
+		return nil, fmt.Errorf("failed to decode apply response: %w", err)
+	}
+	return awaitResult(v, lro, applied)
+}
+
+func testApplyResource(v ValidationActions, ctx *ValidationContext) error {
+	id := v.GenerateID()
+	rURL := fmt.Sprintf("%s/%s", ctx.CollectionURL, id)
+	payload, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to generate apply payload: %w", err)
+	}
+
+	// Step 1: Apply to a new path creates the resource.
+	created, err := applyResource(v, applyIsLongRunning(ctx), rURL, payload)
+	if err != nil {
+		return fmt.Errorf("create on apply: %w", err)
+	}
+	ctx.Resources = append(ctx.Resources, created)
+	if getIDFromResourceName(resourceName(created)) != id {
+		return fmt.Errorf("applied resource has path %q, expected it to end in %q", resourceName(created), id)
+	}
+	if diffs := diffFields(payload, created); len(diffs) > 0 {
+		return fmt.Errorf("applied resource does not match request (fields: %s)", strings.Join(diffs, ", "))
+	}
+	v.Logger().Println("   Apply created the resource.")
+
+	// Step 2: A second apply replaces the resource, resetting omitted fields.
+	omitted := clearableField(ctx, created)
+	replacement, err := replacementBody(ctx, created, omitted)
+	if err != nil {
+		return err
+	}
+	if _, err := applyResource(v, applyIsLongRunning(ctx), rURL, replacement); err != nil {
+		return fmt.Errorf("replace on apply: %w", err)
+	}
+	fetched, err := v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to get resource after apply: %w", err)
+	}
+	if diffs := diffFields(replacement, fetched); len(diffs) > 0 {
+		return fmt.Errorf("fields were not replaced by apply: %s", strings.Join(diffs, ", "))
+	}
+	if omitted != "" && !isZeroValue(fetched[omitted]) {
+		return fmt.Errorf("expected %q omitted from apply to be reset, got %v", omitted, fetched[omitted])
+	}
+	v.Logger().Println("   Apply replaced the resource.")
+
+	// Step 3: Applying the same body twice is idempotent.
+	first, err := applyResource(v, applyIsLongRunning(ctx), rURL, replacement)
+	if err != nil {
+		return fmt.Errorf("repeated apply: %w", err)
+	}
+	second, err := applyResource(v, applyIsLongRunning(ctx), rURL, replacement)
+	if err != nil {
+		return fmt.Errorf("repeated apply: %w", err)
+	}
+	if diffs := diffResources(first, second); len(diffs) > 0 {
+		return fmt.Errorf("applying the same body twice changed the resource (fields: %s)", strings.Join(diffs, ", "))
+	}
+	v.Logger().Println("   Apply is idempotent.")
+	return nil
+}
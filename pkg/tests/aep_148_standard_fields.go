@@ -0,0 +1,165 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+	"github.com/aep-dev/aep-lib-go/pkg/cases"
+)
+
+var TestAEP148StandardFields = Test{
+	Name:     "aep-148-standard-fields",
+	URL:      "https://aep.dev/148",
+	Setup:    setupStandardFields,
+	Run:      testStandardFields,
+	Teardown: teardownDeleteAllResources,
+}
+
+func setupStandardFields(v ValidationActions, ctx *ValidationContext) error {
+	for len(ctx.Resources) < 2 {
+		if err := testCreateResource(v, ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// standardField returns the value of a standard field in either its snake_case
+// or camelCase form, along with the key it was found under.
+func standardField(ctx *ValidationContext, resource map[string]interface{}, snake string) (string, interface{}) {
+	// cases.SnakeToCamelCase capitalizes the first letter as well.
+	camel := cases.SnakeToCamelCase(snake)
+	camel = strings.ToLower(camel[:1]) + camel[1:]
+	for _, key := range []string{snake, camel} {
+		if _, declared := ctx.Resource.Schema.Properties[key]; declared {
+			return key, resource[key]
+		}
+	}
+	return "", nil
+}
+
+// parseTimestamp parses an RFC 3339 timestamp field.
+func parseTimestamp(key string, value interface{}) (time.Time, error) {
+	s, ok := value.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%s: expected an RFC 3339 string, got %v", key, value)
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s: %q is not RFC 3339: %w", key, s, err)
+	}
+	return t, nil
+}
+
+func testStandardFields(v ValidationActions, ctx *ValidationContext) error {
+	// Step 1: path matches the resource pattern and resolves to the resource.
+	uids := make(map[string]string)
+	for _, created := range ctx.Resources {
+		path := resourceName(created)
+		if !utils.MatchesPattern(path, ctx.Resource.PatternElems()) {
+			return fmt.Errorf("path %q does not match pattern %q", path, ctx.Resource.GetPattern())
+		}
+		collectionPath := strings.TrimPrefix(ctx.CollectionURL, ctx.Resource.API.ServerURL+"/")
+		if !strings.HasPrefix(path, collectionPath+"/") {
+			return fmt.Errorf("path %q is not in the collection %q", path, collectionPath)
+		}
+		fetched, err := v.Get(resourceURL(ctx, created))
+		if err != nil {
+			return fmt.Errorf("failed to get resource by its path: %w", err)
+		}
+		if resourceName(fetched) != path {
+			return fmt.Errorf("get returned path %q, expected %q", resourceName(fetched), path)
+		}
+
+		// Step 2: uid is present and unique across resources.
+		if key, uid := standardField(ctx, created, "uid"); key != "" {
+			s, _ := uid.(string)
+			if s == "" {
+				return fmt.Errorf("%s is missing on %s", key, path)
+			}
+			if other, ok := uids[s]; ok {
+				return fmt.Errorf("%s %q is shared by %s and %s", key, s, other, path)
+			}
+			uids[s] = path
+		}
+	}
+	v.Logger().Println("   Paths match the resource pattern and uids are unique.")
+
+	// Step 3: reads do not change any standard field.
+	rURL := resourceURL(ctx, ctx.Resources[0])
+	first, err := v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to get resource: %w", err)
+	}
+	second, err := v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to get resource: %w", err)
+	}
+	for _, field := range []string{"uid", "create_time", "update_time"} {
+		key, before := standardField(ctx, first, field)
+		if key == "" {
+			continue
+		}
+		if _, after := standardField(ctx, second, field); !jsonEqual(before, after) {
+			return fmt.Errorf("%s changed between reads from %v to %v", key, before, after)
+		}
+	}
+	createKey, createTime := standardField(ctx, first, "create_time")
+	if createKey != "" {
+		if _, err := parseTimestamp(createKey, createTime); err != nil {
+			return err
+		}
+	}
+	updateKey, updateTime := standardField(ctx, first, "update_time")
+	var updatedBefore time.Time
+	if updateKey != "" {
+		if updatedBefore, err = parseTimestamp(updateKey, updateTime); err != nil {
+			return err
+		}
+	}
+	v.Logger().Println("   Standard fields are stable across reads.")
+
+	// Step 4: an update keeps uid and create_time and advances update_time.
+	fields := mutableFields(ctx)
+	if ctx.Resource.Methods.Update == nil || len(fields) == 0 {
+		v.Logger().Println("   Resource cannot be updated, skipping update checks.")
+		return nil
+	}
+	body, err := changedValues(ctx, fields[:1], first)
+	if err != nil {
+		return err
+	}
+	if _, err := patchResource(v, updateIsLongRunning(ctx), rURL, body); err != nil {
+		return err
+	}
+	updated, err := v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to get resource after update: %w", err)
+	}
+	for _, field := range []string{"uid", "create_time"} {
+		key, before := standardField(ctx, first, field)
+		if key == "" {
+			continue
+		}
+		if _, after := standardField(ctx, updated, field); !jsonEqual(before, after) {
+			return fmt.Errorf("%s changed on update from %v to %v", key, before, after)
+		}
+	}
+	if updateKey != "" {
+		_, value := standardField(ctx, updated, "update_time")
+		updatedAfter, err := parseTimestamp(updateKey, value)
+		if err != nil {
+			return err
+		}
+		// A timestamp with second precision may not advance within the same
+		// second, so only require it to move forward if it has sub-second
+		// precision.
+		if updatedAfter.Before(updatedBefore) || (updatedAfter.Equal(updatedBefore) && updatedBefore.Nanosecond() != 0) {
+			return fmt.Errorf("%s did not advance on update (%s -> %s)", updateKey, updatedBefore.Format(time.RFC3339Nano), updatedAfter.Format(time.RFC3339Nano))
+		}
+	}
+	v.Logger().Println("   Update preserved uid and create_time and advanced update_time.")
+	return nil
+}
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+)
+
+var TestAEP151LongRunningOperations = Test{
+	Name:         "aep-151-long-running-operations",
+	URL:          "https://aep.dev/151",
+	Precondition: preconditionLongRunningOperations,
+	Run:          testLongRunningOperations,
+	Teardown:     teardownDeleteAllResources,
+}
+
+func preconditionLongRunningOperations(ctx *ValidationContext) error {
+	create := ctx.Resource.Methods.Create
+	if create == nil {
+		return fmt.Errorf("resource does not support create")
+	}
+	if !create.IsLongRunning && !utils.IsLongRunning(ctx.Spec, utils.CollectionPath(ctx.Resource), "POST") {
+		return fmt.Errorf("create is not long-running")
+	}
+	return nil
+}
+
+// checkOperation verifies the standard fields of an Operation.
+func checkOperation(op map[string]interface{}) error {
+	var problems []string
+	if utils.OperationPath(op) == "" {
+		problems = append(problems, "path is missing")
+	}
+	if _, ok := op["done"].(bool); !ok {
+		problems = append(problems, fmt.Sprintf("done must be a boolean, got %v", op["done"]))
+	}
+	if metadata, ok := op["metadata"]; ok && metadata != nil {
+		if _, ok := metadata.(map[string]interface{}); !ok {
+			problems = append(problems, fmt.Sprintf("metadata must be an object, got %v", metadata))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid operation: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func testLongRunningOperations(v ValidationActions, ctx *ValidationContext) error {
+	payload, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to generate create payload: %w", err)
+	}
+
+	// Step 1: A long-running create returns an Operation.
+	resp, err := v.Post(createURL(v, ctx, ctx.CollectionURL), payload)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected 200 from long-running create, got %d: %s", resp.StatusCode, string(body))
+	}
+	var op map[string]interface{}
+	if err := json.Unmarshal(body, &op); err != nil {
+		return fmt.Errorf("failed to decode operation: %w", err)
+	}
+	if err := checkOperation(op); err != nil {
+		return err
+	}
+	v.Logger().Printf("   Got operation %s.\n", utils.OperationPath(op))
+
+	// Step 2: The operation can be retrieved from its path.
+	fetched, err := v.Get(fmt.Sprintf("%s/%s", ctx.Resource.API.ServerURL, utils.OperationPath(op)))
+	if err != nil {
+		return fmt.Errorf("failed to get operation: %w", err)
+	}
+	if err := checkOperation(fetched); err != nil {
+		return err
+	}
+	if utils.OperationPath(fetched) != utils.OperationPath(op) {
+		return fmt.Errorf("get returned operation %s, expected %s", utils.OperationPath(fetched), utils.OperationPath(op))
+	}
+	v.Logger().Println("   Operation retrieved by its path.")
+
+	// Step 3: The operation completes with the created resource.
+	created, err := v.WaitOperation(fetched)
+	if err != nil {
+		return err
+	}
+	if resourceName(created) == "" {
+		return fmt.Errorf("operation response is not a resource: %v", created)
+	}
+	ctx.Resources = append(ctx.Resources, created)
+	v.Logger().Printf("   Operation completed with %s.\n", resourceName(created))
+	return nil
+}
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+var TestAEP154ETag = Test{
+	Name:         "aep-154-etag",
+	URL:          "https://aep.dev/154",
+	Precondition: preconditionETag,
+	Setup:        setupUpdateResource,
+	Run:          testETag,
+	Teardown:     testDeleteResource,
+}
+
+func preconditionETag(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Update == nil {
+		return fmt.Errorf("resource does not support update")
+	}
+	if _, ok := ctx.Resource.Schema.Properties["etag"]; !ok {
+		return fmt.Errorf("resource does not declare an etag field")
+	}
+	if len(mutableFields(ctx)) == 0 {
+		return fmt.Errorf("resource has no mutable fields to update")
+	}
+	return nil
+}
+
+// responseETag returns the etag of a response, preferring the ETag header over
+// the etag field of the decoded resource.
+func responseETag(resp *http.Response, resource map[string]interface{}) string {
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		return etag
+	}
+	etag, _ := resource["etag"].(string)
+	return etag
+}
+
+// readETagResponse consumes a response and returns its status code, decoded
+// body and etag.
+func readETagResponse(resp *http.Response, err error) (int, map[string]interface{}, string, error) {
+	status, resource, err := readResponse(resp, err)
+	if err != nil {
+		return status, nil, "", err
+	}
+	return status, resource, responseETag(resp, resource), nil
+}
+
+func ifMatch(etag string) http.Header {
+	return http.Header{"If-Match": []string{etag}}
+}
+
+// withETag sets the etag field of an update body, so that servers reading the
+// etag from the body see it too.
+func withETag(body map[string]interface{}, etag string) map[string]interface{} {
+	body["etag"] = etag
+	return body
+}
+
+func testETag(v ValidationActions, ctx *ValidationContext) error {
+	rURL := resourceURL(ctx, ctx.Resources[0])
+
+	_, current, etag, err := readETagResponse(v.GetReq(rURL))
+	if err != nil {
+		return err
+	}
+	if etag == "" {
+		return fmt.Errorf("resource declares an etag field, but get returned no etag")
+	}
+	field := mutableFields(ctx)[0]
+
+	// Step 1: Update with the current etag succeeds and changes the etag.
+	staleETag := etag
+	for i := 0; i < 2; i++ {
+		changed, err := changedValues(ctx, []string{field}, current)
+		if err != nil {
+			return err
+		}
+		body := withETag(changed, etag)
+		status, updated, newETag, err := readETagResponse(v.PatchWithHeaders(rURL, body, ifMatch(etag)))
+		if err != nil {
+			return err
+		}
+		if status != http.StatusOK {
+			return fmt.Errorf("update with current etag returned %d, expected 200", status)
+		}
+		if updateIsLongRunning(ctx) {
+			if updated, err = awaitResult(v, true, updated); err != nil {
+				return err
+			}
+			newETag, _ = updated["etag"].(string)
+		}
+		if newETag == "" || newETag == etag {
+			return fmt.Errorf("etag did not change after update (was %q, now %q)", etag, newETag)
+		}
+		current, etag = updated, newETag
+	}
+	v.Logger().Println("   Updates with current etag succeeded and changed the etag.")
+
+	// Step 2: Update with a stale etag fails.
+	changed, err := changedValues(ctx, []string{field}, current)
+	if err != nil {
+		return err
+	}
+	body := withETag(changed, staleETag)
+	status, _, _, err := readETagResponse(v.PatchWithHeaders(rURL, body, ifMatch(staleETag)))
+	if err != nil {
+		return err
+	}
+	if status != http.StatusPreconditionFailed && status != http.StatusConflict {
+		return fmt.Errorf("update with stale etag returned %d, expected 412/409", status)
+	}
+	v.Logger().Println("   Update with stale etag rejected.")
+
+	// Step 3: Delete with a stale etag fails and leaves the resource in place.
+	status, _, _, err = readETagResponse(v.DeleteReqWithHeaders(rURL, ifMatch(staleETag)))
+	if err != nil {
+		return err
+	}
+	if status == http.StatusOK || status == http.StatusNoContent {
+		ctx.Resources = ctx.Resources[1:]
+		return fmt.Errorf("delete with stale etag succeeded (status %d), expected 412/409", status)
+	}
+	if status != http.StatusPreconditionFailed && status != http.StatusConflict {
+		return fmt.Errorf("delete with stale etag returned %d, expected 412/409", status)
+	}
+	if _, err := v.Get(rURL); err != nil {
+		return fmt.Errorf("resource missing after rejected delete: %w", err)
+	}
+	v.Logger().Println("   Delete with stale etag rejected.")
+	return nil
+}
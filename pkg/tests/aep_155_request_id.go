@@ -0,0 +1,226 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+)
+
+var TestAEP155RequestID = Test{
+	Name:         "aep-155-request-id",
+	URL:          "https://aep.dev/155",
+	Precondition: preconditionRequestID,
+	Run:          testRequestID,
+	Teardown:     teardownDeleteAllResources,
+}
+
+// createRequestIDParam returns the name of the request ID query parameter of
+// the create method, or "" if it does not accept one.
+func createRequestIDParam(ctx *ValidationContext) string {
+	op := utils.FindOperation(ctx.Spec, utils.CollectionPath(ctx.Resource), "POST")
+	return utils.FindQueryParam(op, "request_id", "requestId")
+}
+
+// requestIDField returns the request ID field of a custom method request, or
+// "" if it does not have one.
+func requestIDField(cm *api.CustomMethod) string {
+	if cm.Method != "POST" || cm.Request == nil {
+		return ""
+	}
+	for _, name := range []string{"request_id", "requestId"} {
+		if _, ok := cm.Request.Properties[name]; ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// requestIDCustomMethods returns the resource-scoped custom methods that
+// accept a request ID and may be invoked, as decided for aep-136-custom-methods.
+// Long-running methods are left out.
+func requestIDCustomMethods(ctx *ValidationContext) ([]*api.CustomMethod, error) {
+	invocable, _, err := invocableCustomMethods(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var methods []*api.CustomMethod
+	for _, cm := range invocable {
+		if requestIDField(cm) != "" && !cm.IsLongRunning {
+			methods = append(methods, cm)
+		}
+	}
+	return methods, nil
+}
+
+func preconditionRequestID(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Create != nil && createRequestIDParam(ctx) != "" {
+		return nil
+	}
+	methods, err := requestIDCustomMethods(ctx)
+	if err != nil {
+		return err
+	}
+	if len(methods) == 0 {
+		return fmt.Errorf("no method that can be invoked accepts a request_id (POST custom methods require --invoke-custom-methods)")
+	}
+	if ctx.Resource.Methods.Create == nil {
+		return fmt.Errorf("resource does not support create")
+	}
+	return nil
+}
+
+// createIsLongRunning reports whether the create method of the resource
+// returns an operation.
+func createIsLongRunning(ctx *ValidationContext) bool {
+	create := ctx.Resource.Methods.Create
+	if create != nil && create.IsLongRunning {
+		return true
+	}
+	return utils.IsLongRunning(ctx.Spec, utils.CollectionPath(ctx.Resource), "POST")
+}
+
+// readCreated consumes a create response and returns its status code and, for
+// a successful response, the created resource, waiting on the operation of a
+// long-running create.
+func readCreated(v ValidationActions, ctx *ValidationContext, resp *http.Response, err error) (int, map[string]interface{}, error) {
+	status, created, err := readResponse(resp, err)
+	if err != nil || created == nil {
+		return status, nil, err
+	}
+	created, err = awaitResult(v, createIsLongRunning(ctx), created)
+	return status, created, err
+}
+
+// countResources returns the number of resources in the collection under test.
+func countResources(v ValidationActions, ctx *ValidationContext) (int, error) {
+	count := 0
+	pageToken := ""
+	for {
+		listResp, err := utils.FetchList(v, ctx.CollectionURL, pageToken, 0, nil)
+		if err != nil {
+			return 0, fmt.Errorf("failed to list resources: %w", err)
+		}
+		count += len(listResp.Resources)
+		pageToken = listResp.NextPageToken
+		if pageToken == "" {
+			return count, nil
+		}
+	}
+}
+
+func testRequestID(v ValidationActions, ctx *ValidationContext) error {
+	if param := createRequestIDParam(ctx); ctx.Resource.Methods.Create != nil && param != "" {
+		if err := testCreateRequestID(v, ctx, param); err != nil {
+			return err
+		}
+	}
+	return testCustomMethodRequestID(v, ctx)
+}
+
+func testCreateRequestID(v ValidationActions, ctx *ValidationContext, param string) error {
+	payload, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to generate create payload: %w", err)
+	}
+	rURL := createURL(v, ctx, ctx.CollectionURL)
+	query := url.Values{param: []string{utils.GenerateRequestID()}}
+
+	before := 0
+	if ctx.Resource.Methods.List != nil {
+		if before, err = countResources(v, ctx); err != nil {
+			return err
+		}
+	}
+
+	// Step 1: Sending the same create twice yields one resource.
+	var responses []map[string]interface{}
+	for i := 0; i < 2; i++ {
+		resp, err := v.PostWithQuery(rURL, payload, query)
+		status, created, err := readCreated(v, ctx, resp, err)
+		if err != nil {
+			return err
+		}
+		if created == nil {
+			return fmt.Errorf("create %d with request ID returned %d, expected 200", i+1, status)
+		}
+		responses = append(responses, created)
+	}
+	ctx.Resources = append(ctx.Resources, responses[0])
+	if diffs := diffResources(responses[0], responses[1]); len(diffs) > 0 {
+		return fmt.Errorf("retried create returned a different response (fields: %s)", strings.Join(diffs, ", "))
+	}
+	if ctx.Resource.Methods.List != nil {
+		after, err := countResources(v, ctx)
+		if err != nil {
+			return err
+		}
+		if after != before+1 {
+			return fmt.Errorf("expected 1 resource to be created, collection grew by %d", after-before)
+		}
+	}
+	v.Logger().Println("   Retried create returned the original resource.")
+
+	// Step 2: Reusing the request ID with a different body is rejected.
+	other, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to generate create payload: %w", err)
+	}
+	resp, err := v.PostWithQuery(createURL(v, ctx, ctx.CollectionURL), other, query)
+	status, created, err := readCreated(v, ctx, resp, err)
+	if err != nil {
+		return err
+	}
+	if created != nil {
+		if resourceName(created) != resourceName(responses[0]) {
+			ctx.Resources = append(ctx.Resources, created)
+		}
+		return fmt.Errorf("reusing a request ID with a different body succeeded (status %d), expected 400/409", status)
+	}
+	if status != http.StatusBadRequest && status != http.StatusConflict {
+		return fmt.Errorf("reusing a request ID with a different body returned %d, expected 400/409", status)
+	}
+	v.Logger().Println("   Reused request ID with a different body rejected.")
+	return nil
+}
+
+func testCustomMethodRequestID(v ValidationActions, ctx *ValidationContext) error {
+	methods, err := requestIDCustomMethods(ctx)
+	if err != nil {
+		return err
+	}
+	for _, cm := range methods {
+		field := requestIDField(cm)
+		if len(ctx.Resources) == 0 {
+			if err := testCreateResource(v, ctx); err != nil {
+				return err
+			}
+		}
+		body, err := ctx.Generator.GeneratePayload(cm.Request)
+		if err != nil {
+			return fmt.Errorf("failed to generate request for %s: %w", cm.Name, err)
+		}
+		body[field] = utils.GenerateRequestID()
+		cmURL := fmt.Sprintf("%s:%s", resourceURL(ctx, ctx.Resources[0]), cm.Name)
+
+		var responses []map[string]interface{}
+		for i := 0; i < 2; i++ {
+			status, result, err := readResponse(v.Post(cmURL, body))
+			if err != nil {
+				return err
+			}
+			if status != http.StatusOK {
+				return fmt.Errorf("%s call %d with request ID returned %d, expected 200", cm.Name, i+1, status)
+			}
+			responses = append(responses, result)
+		}
+		if diffs := diffResources(responses[0], responses[1]); len(diffs) > 0 {
+			return fmt.Errorf("retried %s returned a different response (fields: %s)", cm.Name, strings.Join(diffs, ", "))
+		}
+		v.Logger().Printf("   Retried %s returned the original response.\n", cm.Name)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+)
+
+var TestAEP156Singleton = Test{
+	Name:         "aep-156-singleton",
+	URL:          "https://aep.dev/156",
+	Precondition: preconditionSingleton,
+	Setup:        setupSingleton,
+	Run:          testSingleton,
+	Teardown:     teardownDeleteAllResources,
+}
+
+func preconditionSingleton(ctx *ValidationContext) error {
+	singletons, err := utils.Singletons(ctx.Spec, ctx.Resource)
+	if err != nil {
+		return err
+	}
+	if len(singletons) == 0 {
+		return fmt.Errorf("resource has no singletons")
+	}
+	if ctx.Resource.Methods.Create == nil {
+		return fmt.Errorf("resource does not support create")
+	}
+	return nil
+}
+
+func setupSingleton(v ValidationActions, ctx *ValidationContext) error {
+	parent, err := utils.CreateResource(v, ctx.Generator, ctx.Resource, ctx.CollectionURL)
+	if err != nil {
+		return err
+	}
+	ctx.Resources = append(ctx.Resources, parent)
+	return nil
+}
+
+// singletonFields returns the sorted fields of a singleton that a client can
+// change with an update, and for which a different value can be generated.
+func singletonFields(ctx *ValidationContext, s *utils.Singleton) []string {
+	payload, err := ctx.Generator.GeneratePayload(s.Schema)
+	if err != nil {
+		return nil
+	}
+	immutable := make(map[string]bool)
+	for _, f := range utils.ImmutableFields(s.Schema) {
+		immutable[f] = true
+	}
+	var fields []string
+	for name := range payload {
+		if !immutable[name] && canChangeValue(ctx, s.Schema, name) {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func testSingleton(v ValidationActions, ctx *ValidationContext) error {
+	singletons, err := utils.Singletons(ctx.Spec, ctx.Resource)
+	if err != nil {
+		return err
+	}
+	parent := ctx.Resources[len(ctx.Resources)-1]
+	for _, s := range singletons {
+		sName := fmt.Sprintf("%s/%s", resourceName(parent), s.Name)
+		sURL := resourceURL(ctx, parent) + "/" + s.Name
+
+		// Step 1: The singleton exists as soon as its parent does.
+		singleton, err := v.Get(sURL)
+		if err != nil {
+			return fmt.Errorf("get %s: %w", sName, err)
+		}
+		if path, ok := singleton["path"].(string); ok && path != sName {
+			return fmt.Errorf("get %s returned path %q", sName, path)
+		}
+		v.Logger().Printf("   Got singleton %s.\n", sName)
+
+		// Step 2: Updates persist.
+		if fields := singletonFields(ctx, s); s.Update && len(fields) > 0 {
+			field := fields[0]
+			value, err := changedSchemaValue(ctx, s.Schema, field, singleton[field])
+			if err != nil {
+				return fmt.Errorf("update %s: %w", sName, err)
+			}
+			body := map[string]interface{}{field: value}
+			lro := utils.IsLongRunning(ctx.Spec, utils.ResourcePath(ctx.Resource)+"/"+s.Name, "PATCH")
+			if _, err := patchResource(v, lro, sURL, body); err != nil {
+				return fmt.Errorf("update %s: %w", sName, err)
+			}
+			fetched, err := v.Get(sURL)
+			if err != nil {
+				return fmt.Errorf("get %s after update: %w", sName, err)
+			}
+			if diffs := diffFields(body, fetched); len(diffs) > 0 {
+				return fmt.Errorf("update of %s was not persisted: %s", sName, strings.Join(diffs, ", "))
+			}
+			v.Logger().Printf("   Update of %q persisted.\n", field)
+		}
+
+		// Step 3: A singleton can not be created or deleted.
+		resp, err := v.Post(sURL, map[string]interface{}{})
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		if err := expectStatus(resp, http.StatusMethodNotAllowed, http.StatusNotFound); err != nil {
+			return fmt.Errorf("create %s: %w", sName, err)
+		}
+		resp, err = v.DeleteReq(sURL)
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		if err := expectStatus(resp, http.StatusMethodNotAllowed, http.StatusNotFound); err != nil {
+			return fmt.Errorf("delete %s: %w", sName, err)
+		}
+		if _, err := v.Get(sURL); err != nil {
+			return fmt.Errorf("get %s after delete was rejected: %w", sName, err)
+		}
+		v.Logger().Printf("   Create and delete of %s rejected.\n", sName)
+	}
+
+	// Step 4: Singletons are deleted along with their parent.
+	if ctx.Resource.Methods.Delete == nil {
+		v.Logger().Println("   Resource does not support delete, skipping implicit delete check.")
+		return nil
+	}
+	if err := v.Delete(resourceURL(ctx, parent)); err != nil {
+		return fmt.Errorf("delete parent %s: %w", resourceName(parent), err)
+	}
+	ctx.Resources = ctx.Resources[:len(ctx.Resources)-1]
+	for _, s := range singletons {
+		resp, err := v.GetReq(resourceURL(ctx, parent) + "/" + s.Name)
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		if err := expectStatus(resp, http.StatusNotFound); err != nil {
+			return fmt.Errorf("get %s/%s after deleting its parent: %w", resourceName(parent), s.Name, err)
+		}
+	}
+	v.Logger().Println("   Singletons deleted with their parent.")
+	return nil
+}
@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+)
+
+var TestAEP157ReadMask = Test{
+	Name:         "aep-157-read-mask",
+	URL:          "https://aep.dev/157",
+	Precondition: preconditionReadMask,
+	Setup:        setupUpdateResource,
+	Run:          testReadMask,
+	Teardown:     testDeleteResource,
+}
+
+// getReadMaskParam and listReadMaskParam return the name of the partial
+// response parameter accepted by the get and list methods, or "" if none.
+func getReadMaskParam(ctx *ValidationContext) string {
+	op := utils.FindOperation(ctx.Spec, utils.ResourcePath(ctx.Resource), "GET")
+	return utils.FindQueryParam(op, "read_mask", "readMask", "fields")
+}
+
+func listReadMaskParam(ctx *ValidationContext) string {
+	op := utils.FindOperation(ctx.Spec, utils.CollectionPath(ctx.Resource), "GET")
+	return utils.FindQueryParam(op, "read_mask", "readMask", "fields")
+}
+
+func preconditionReadMask(ctx *ValidationContext) error {
+	if getReadMaskParam(ctx) == "" && listReadMaskParam(ctx) == "" {
+		return fmt.Errorf("neither get nor list accept a read mask")
+	}
+	if len(mutableFields(ctx)) == 0 {
+		return fmt.Errorf("resource has no fields to mask")
+	}
+	return nil
+}
+
+// unmaskedFields returns the sorted fields of the resource outside of the mask.
+// The path is always allowed, since it identifies the resource.
+func unmaskedFields(resource map[string]interface{}, field string) []string {
+	var extra []string
+	for k := range resource {
+		if k != field && k != "path" && k != "name" {
+			extra = append(extra, k)
+		}
+	}
+	sort.Strings(extra)
+	return extra
+}
+
+func testReadMask(v ValidationActions, ctx *ValidationContext) error {
+	field := mutableFields(ctx)[0]
+	rURL := resourceURL(ctx, ctx.Resources[0])
+	full, err := v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to get resource: %w", err)
+	}
+
+	if param := getReadMaskParam(ctx); param != "" {
+		// Step 1: Only the requested field is returned.
+		masked, err := v.Get(withQuery(rURL, param, field))
+		if err != nil {
+			return fmt.Errorf("get with %s=%s: %w", param, field, err)
+		}
+		if extra := unmaskedFields(masked, field); len(extra) > 0 {
+			return fmt.Errorf("get with %s=%s returned unrequested fields: %s", param, field, strings.Join(extra, ", "))
+		}
+		if diffs := diffFields(map[string]interface{}{field: full[field]}, masked); len(diffs) > 0 {
+			return fmt.Errorf("get with %s=%s did not return %q", param, field, field)
+		}
+		v.Logger().Printf("   Get with %s=%s returned only %q.\n", param, field, field)
+
+		// Step 2: "*" returns every field.
+		all, err := v.Get(withQuery(rURL, param, "*"))
+		if err != nil {
+			return fmt.Errorf("get with %s=*: %w", param, err)
+		}
+		if diffs := diffResources(full, all); len(diffs) > 0 {
+			return fmt.Errorf("get with %s=* differs from a full get (fields: %s)", param, strings.Join(diffs, ", "))
+		}
+		v.Logger().Printf("   Get with %s=* returned every field.\n", param)
+
+		// Step 3: An unknown field path is rejected.
+		resp, err := v.GetReq(withQuery(rURL, param, "nonexistent_field_path"))
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		if err := expectStatus(resp, http.StatusBadRequest); err != nil {
+			return fmt.Errorf("get with an unknown field in %s: %w", param, err)
+		}
+		v.Logger().Println("   Unknown field path rejected.")
+	}
+
+	if param := listReadMaskParam(ctx); param != "" && ctx.Resource.Methods.List != nil {
+		// Step 4: Listed resources only hold the requested field.
+		listResp, err := utils.FetchList(v, ctx.CollectionURL, "", 0, url.Values{param: {field}})
+		if err != nil {
+			return fmt.Errorf("list with %s=%s: %w", param, field, err)
+		}
+		if len(listResp.Resources) == 0 {
+			return fmt.Errorf("list with %s=%s returned no resources", param, field)
+		}
+		for _, r := range listResp.Resources {
+			if extra := unmaskedFields(r, field); len(extra) > 0 {
+				return fmt.Errorf("list with %s=%s returned unrequested fields: %s", param, field, strings.Join(extra, ", "))
+			}
+		}
+		v.Logger().Printf("   List with %s=%s returned only %q.\n", param, field, field)
+
+		resp, err := v.GetReq(withQuery(ctx.CollectionURL, param, "nonexistent_field_path"))
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		if err := expectStatus(resp, http.StatusBadRequest); err != nil {
+			return fmt.Errorf("list with an unknown field in %s: %w", param, err)
+		}
+	}
+	return nil
+}
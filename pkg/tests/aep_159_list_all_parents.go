@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+)
+
+var TestAEP159ListAllParents = Test{
+	Name:         "aep-159-list-all-parents",
+	URL:          "https://aep.dev/159",
+	Precondition: preconditionParentIsolation,
+	Setup:        setupSiblingParents,
+	Run:          testListAllParents,
+	Teardown:     teardownSiblingParents,
+}
+
+func testListAllParents(v ValidationActions, ctx *ValidationContext) error {
+	parentA, parentB := siblingParents(ctx)
+	for _, child := range listableChildren(ctx.Resource) {
+		elems := child.PatternElems()
+		listURL := fmt.Sprintf("%s/%s/%s", ctx.Resource.API.ServerURL, siblingName(resourceName(parentA), "-"), elems[len(elems)-2])
+
+		// Step 1: The wildcard is either supported or cleanly rejected.
+		resp, err := v.GetReq(listURL)
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		if resp.StatusCode == http.StatusBadRequest {
+			if err := expectErrorResponse(resp, http.StatusBadRequest); err != nil {
+				return fmt.Errorf("list of %s: %w", listURL, err)
+			}
+			v.Logger().Printf("   Listing %s across parents is not supported, got 400.\n", child.Plural)
+			continue
+		}
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return fmt.Errorf("list of %s: expected 200 or 400, got %d: %s", listURL, resp.StatusCode, string(body))
+		}
+		resp.Body.Close()
+
+		// Step 2: Children of every parent are returned with their full paths.
+		want := map[string]bool{
+			resourceName(childOf(ctx, parentA, child)): false,
+			resourceName(childOf(ctx, parentB, child)): false,
+		}
+		if err := checkWildcardList(v, listURL, elems, want); err != nil {
+			return err
+		}
+		v.Logger().Printf("   Listed %s across parents.\n", child.Plural)
+	}
+	return nil
+}
+
+// checkWildcardList pages through a list using the "-" wildcard, verifying
+// every resource has a full path matching patternElems and marking the
+// resources in want that were listed.
+func checkWildcardList(v ValidationActions, listURL string, patternElems []string, want map[string]bool) error {
+	pageToken := ""
+	for {
+		listResp, err := utils.FetchList(v, listURL, pageToken, 0, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %w", listURL, err)
+		}
+		for _, r := range listResp.Resources {
+			name := resourceName(r)
+			if !utils.MatchesPattern(name, patternElems) || strings.Contains("/"+name+"/", "/-/") {
+				return fmt.Errorf("list of %s returned %q, which is not a full resource path", listURL, name)
+			}
+			if _, ok := want[name]; ok {
+				want[name] = true
+			}
+		}
+		pageToken = listResp.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+	var missing []string
+	for name, found := range want {
+		if !found {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("list of %s did not return %s", listURL, strings.Join(missing, ", "))
+	}
+	return nil
+}
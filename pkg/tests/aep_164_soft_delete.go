@@ -0,0 +1,195 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+	"github.com/aep-dev/aep-lib-go/pkg/constants"
+)
+
+var TestAEP164SoftDelete = Test{
+	Name:         "aep-164-soft-delete",
+	URL:          "https://aep.dev/164",
+	Precondition: preconditionSoftDelete,
+	Setup:        setupSoftDelete,
+	Run:          testSoftDelete,
+	Teardown:     teardownSoftDelete,
+}
+
+// showDeletedParam returns the name of the list parameter to include
+// soft-deleted resources, or "" if the list method does not accept one.
+func showDeletedParam(ctx *ValidationContext) string {
+	op := utils.FindOperation(ctx.Spec, utils.CollectionPath(ctx.Resource), "GET")
+	return utils.FindQueryParam(op, "show_deleted", "showDeleted")
+}
+
+// supportsPostMethod reports whether the resource declares a POST custom
+// method with the given name.
+func supportsPostMethod(ctx *ValidationContext, name string) bool {
+	for _, cm := range ctx.Resource.CustomMethods {
+		if cm.Name == name && cm.Method == "POST" {
+			return true
+		}
+	}
+	return false
+}
+
+func supportsUndelete(ctx *ValidationContext) bool {
+	return supportsPostMethod(ctx, "undelete")
+}
+
+// softDeletes reports whether the resource declares soft delete, through a
+// delete_time field, an undelete method or a show_deleted list parameter.
+// Deleted resources then remain retrievable until they expire.
+func softDeletes(ctx *ValidationContext) bool {
+	if ctx.Resource.Methods.Delete == nil {
+		return false
+	}
+	deleteTimeKey, _ := standardField(ctx, nil, "delete_time")
+	return deleteTimeKey != "" || supportsUndelete(ctx) || showDeletedParam(ctx) != ""
+}
+
+func preconditionSoftDelete(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Delete == nil {
+		return fmt.Errorf("resource does not support delete")
+	}
+	if !softDeletes(ctx) {
+		return fmt.Errorf("resource does not declare soft delete")
+	}
+	return nil
+}
+
+// purgeSoftDeleted permanently removes a soft-deleted resource, through the
+// expire method or a forced delete. A resource declaring neither is left for
+// the server to expire, as there is no other way to remove it.
+func purgeSoftDeleted(v ValidationActions, ctx *ValidationContext, rURL string) error {
+	switch {
+	case supportsPostMethod(ctx, "expire"):
+		resp, err := v.Post(rURL+":expire", map[string]interface{}{})
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		if err := expectStatus(resp, http.StatusOK, http.StatusNotFound); err != nil {
+			return fmt.Errorf("expire: %w", err)
+		}
+	case supportsForceDelete(ctx):
+		err := v.Delete(withQuery(rURL, constants.FIELD_FORCE_NAME, "true"))
+		if err != nil && !strings.Contains(err.Error(), "status 404") {
+			return fmt.Errorf("delete with force: %w", err)
+		}
+	}
+	return nil
+}
+
+func setupSoftDelete(v ValidationActions, ctx *ValidationContext) error {
+	return testCreateResource(v, ctx)
+}
+
+// checkDeleteTime verifies the delete_time field, if declared, is set.
+func checkDeleteTime(ctx *ValidationContext, resource map[string]interface{}) error {
+	key, value := standardField(ctx, resource, "delete_time")
+	if key == "" {
+		return nil
+	}
+	if _, err := parseTimestamp(key, value); err != nil {
+		return fmt.Errorf("soft-deleted resource has no valid %s: %w", key, err)
+	}
+	return nil
+}
+
+func testSoftDelete(v ValidationActions, ctx *ValidationContext) error {
+	resource := ctx.Resources[len(ctx.Resources)-1]
+	name := resourceName(resource)
+	rURL := resourceURL(ctx, resource)
+
+	// Step 1: Delete the resource.
+	if err := v.Delete(rURL); err != nil {
+		return fmt.Errorf("failed to delete resource: %w", err)
+	}
+	v.Logger().Println("   Delete successful.")
+
+	// Step 2: Soft-deleted resources are hidden from list by default.
+	if ctx.Resource.Methods.List != nil {
+		found, err := listContains(v, ctx, name)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("soft-deleted resource %s is listed without %s", name, showDeletedParam(ctx))
+		}
+		v.Logger().Println("   Soft-deleted resource hidden from list.")
+	}
+
+	// Step 3: Soft-deleted resources are listed when requested.
+	if param := showDeletedParam(ctx); param != "" {
+		listed, err := findInList(v, withQuery(ctx.CollectionURL, param, "true"), name)
+		if err != nil {
+			return err
+		}
+		if listed == nil {
+			return fmt.Errorf("soft-deleted resource %s is not listed with %s=true", name, param)
+		}
+		if err := checkDeleteTime(ctx, listed); err != nil {
+			return err
+		}
+		v.Logger().Printf("   Soft-deleted resource listed with %s=true.\n", param)
+	}
+
+	// Step 4: Soft-deleted resources can still be retrieved.
+	fetched, err := v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("get of a soft-deleted resource should return it: %w", err)
+	}
+	if err := checkDeleteTime(ctx, fetched); err != nil {
+		return err
+	}
+	v.Logger().Println("   Soft-deleted resource can be retrieved.")
+
+	// Step 5: Undelete restores the resource.
+	if !supportsUndelete(ctx) {
+		return nil
+	}
+	resp, err := v.Post(rURL+":undelete", map[string]interface{}{})
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	if err := expectStatus(resp, http.StatusOK); err != nil {
+		return fmt.Errorf("undelete: %w", err)
+	}
+	restored, err := v.Get(rURL)
+	if err != nil {
+		return fmt.Errorf("failed to get undeleted resource: %w", err)
+	}
+	if key, value := standardField(ctx, restored, "delete_time"); key != "" && !isZeroValue(value) {
+		return fmt.Errorf("undeleted resource still has %s %v", key, value)
+	}
+	if ctx.Resource.Methods.List != nil {
+		found, err := listContains(v, ctx, name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("undeleted resource %s is not listed", name)
+		}
+	}
+	v.Logger().Println("   Undelete restored the resource.")
+	return nil
+}
+
+// teardownSoftDelete deletes the resources that are still active and purges
+// the soft-deleted ones.
+func teardownSoftDelete(v ValidationActions, ctx *ValidationContext) error {
+	for _, resource := range ctx.Resources {
+		rURL := resourceURL(ctx, resource)
+		if err := v.Delete(rURL); err != nil && !strings.Contains(err.Error(), "status 404") {
+			return err
+		}
+		if err := purgeSoftDeleted(v, ctx, rURL); err != nil {
+			return err
+		}
+	}
+	ctx.Resources = nil
+	return nil
+}
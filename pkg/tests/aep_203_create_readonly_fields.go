@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+)
+
+var TestAEP203CreateReadOnlyFields = Test{
+	Name:         "aep-203-create-readonly-fields",
+	URL:          "https://aep.dev/203",
+	Precondition: preconditionCreateReadOnlyFields,
+	Run:          testCreateReadOnlyFields,
+	Teardown:     teardownDeleteAllResources,
+}
+
+func preconditionCreateReadOnlyFields(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Create == nil {
+		return fmt.Errorf("resource does not support create")
+	}
+	if len(bogusReadOnlyValues(ctx)) == 0 {
+		return fmt.Errorf("resource has no output only fields that can be checked")
+	}
+	return nil
+}
+
+// bogusReadOnlyValues returns a value for every output only field that a
+// server would never assign itself.
+func bogusReadOnlyValues(ctx *ValidationContext) map[string]interface{} {
+	values := make(map[string]interface{})
+	for name, prop := range ctx.Resource.Schema.Properties {
+		if !prop.ReadOnly {
+			continue
+		}
+		if value := bogusValue(prop); value != nil {
+			values[name] = value
+		}
+	}
+	return values
+}
+
+// bogusValue returns an implausible value for the schema, or nil if the type
+// has no value that can be told apart from one the server may assign.
+func bogusValue(schema openapi.Schema) interface{} {
+	switch schema.Type {
+	case "string":
+		if schema.Format == "date-time" {
+			return "1970-01-01T00:00:00Z"
+		}
+		return "bogus-output-only-value"
+	case "integer", "number":
+		return -987654
+	}
+	return nil
+}
+
+func testCreateReadOnlyFields(v ValidationActions, ctx *ValidationContext) error {
+	payload, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to generate create payload: %w", err)
+	}
+	bogus := bogusReadOnlyValues(ctx)
+	for name, value := range bogus {
+		payload[name] = value
+	}
+
+	created, err := v.CreateResource(ctx.Resource, ctx.CollectionURL, payload)
+	if err != nil {
+		return fmt.Errorf("create with output only fields set should succeed and ignore them: %w", err)
+	}
+	ctx.Resources = append(ctx.Resources, created)
+
+	if fields := acceptedBogusFields(bogus, created); len(fields) > 0 {
+		return fmt.Errorf("create response returned client-provided output only fields: %s", strings.Join(fields, ", "))
+	}
+
+	fetched, err := v.Get(resourceURL(ctx, created))
+	if err != nil {
+		return fmt.Errorf("failed to get created resource: %w", err)
+	}
+	if fields := acceptedBogusFields(bogus, fetched); len(fields) > 0 {
+		return fmt.Errorf("server persisted client-provided output only fields: %s", strings.Join(fields, ", "))
+	}
+	v.Logger().Println("   Output only fields were ignored.")
+	return nil
+}
+
+// acceptedBogusFields returns the names of the fields where the resource holds
+// the bogus value that was sent.
+func acceptedBogusFields(bogus, resource map[string]interface{}) []string {
+	var fields []string
+	for name, value := range bogus {
+		got, ok := resource[name]
+		if !ok {
+			continue
+		}
+		// Compare the printed forms, since JSON numbers decode as float64.
+		if fmt.Sprint(got) == fmt.Sprint(value) {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var TestAEP203CreateRequiredFields = Test{
+	Name:         "aep-203-create-required-fields",
+	URL:          "https://aep.dev/203",
+	Precondition: preconditionCreateRequiredFields,
+	Run:          testCreateRequiredFields,
+	Teardown:     teardownDeleteAllResources,
+}
+
+func preconditionCreateRequiredFields(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Create == nil {
+		return fmt.Errorf("resource does not support create")
+	}
+	if len(requiredCreateFields(ctx)) == 0 {
+		return fmt.Errorf("resource has no required fields")
+	}
+	return nil
+}
+
+// requiredCreateFields returns the required fields that a client must set on
+// create, excluding output only fields.
+func requiredCreateFields(ctx *ValidationContext) []string {
+	var fields []string
+	for _, name := range ctx.Resource.Schema.Required {
+		prop, ok := ctx.Resource.Schema.Properties[name]
+		if !ok || prop.ReadOnly {
+			continue
+		}
+		fields = append(fields, name)
+	}
+	return fields
+}
+
+func testCreateRequiredFields(v ValidationActions, ctx *ValidationContext) error {
+	var failures []string
+	for _, field := range requiredCreateFields(ctx) {
+		payload, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+		if err != nil {
+			return fmt.Errorf("failed to generate create payload: %w", err)
+		}
+		delete(payload, field)
+
+		resp, err := v.Post(createURL(v, ctx, ctx.CollectionURL), payload)
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		switch resp.StatusCode {
+		case http.StatusBadRequest:
+			v.Logger().Printf("   Omitting %q rejected as expected.\n", field)
+		case http.StatusOK, http.StatusCreated:
+			// Track the resource for teardown. A long-running create returns
+			// an operation, so wait for it to get the created resource.
+			var created map[string]interface{}
+			if err := json.Unmarshal(body, &created); err == nil {
+				if created, err = awaitResult(v, createIsLongRunning(ctx), created); err == nil {
+					ctx.Resources = append(ctx.Resources, created)
+				}
+			}
+			failures = append(failures, fmt.Sprintf("%s: create succeeded without required field", field))
+		default:
+			failures = append(failures, fmt.Sprintf("%s: expected 400, got %d: %s", field, resp.StatusCode, string(body)))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("required fields not enforced:\n%s", strings.Join(failures, "\n"))
+	}
+	return nil
+}
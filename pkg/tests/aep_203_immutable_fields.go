@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+)
+
+var TestAEP203ImmutableFields = Test{
+	Name:         "aep-203-immutable-fields",
+	URL:          "https://aep.dev/203",
+	Precondition: preconditionImmutableFields,
+	Setup:        setupImmutableFields,
+	Run:          testImmutableFields,
+	Teardown:     testDeleteResource,
+}
+
+func preconditionImmutableFields(ctx *ValidationContext) error {
+	if ctx.Resource.Methods.Update == nil {
+		return fmt.Errorf("resource does not support update")
+	}
+	if len(utils.ImmutableFields(ctx.Resource.Schema)) == 0 {
+		return fmt.Errorf("resource has no immutable fields")
+	}
+	if len(changeableImmutableFields(ctx)) == 0 {
+		return fmt.Errorf("resource has no immutable fields whose value can be changed")
+	}
+	return nil
+}
+
+func setupImmutableFields(v ValidationActions, ctx *ValidationContext) error {
+	if len(ctx.Resources) == 0 {
+		return testCreateResource(v, ctx)
+	}
+	return nil
+}
+
+func testImmutableFields(v ValidationActions, ctx *ValidationContext) error {
+	rURL := resourceURL(ctx, ctx.Resources[0])
+	var failures []string
+	for _, field := range changeableImmutableFields(ctx) {
+		before, err := v.Get(rURL)
+		if err != nil {
+			return fmt.Errorf("failed to get resource: %w", err)
+		}
+
+		newValue, err := changedValue(ctx, field, before[field])
+		if err != nil {
+			return err
+		}
+		resp, err := v.Patch(rURL, map[string]interface{}{field: newValue})
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			failures = append(failures, fmt.Sprintf("%s: expected 400, got %d: %s", field, resp.StatusCode, string(body)))
+		}
+
+		after, err := v.Get(rURL)
+		if err != nil {
+			return fmt.Errorf("failed to get resource: %w", err)
+		}
+		if diffs := diffFields(map[string]interface{}{field: before[field]}, after); len(diffs) > 0 {
+			failures = append(failures, fmt.Sprintf("%s: value changed from %v to %v", field, before[field], after[field]))
+			continue
+		}
+		v.Logger().Printf("   Immutable field %q was not changed.\n", field)
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("immutable fields not enforced:\n%s", strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+// changeableImmutableFields returns the immutable fields for which a different
+// value can be generated.
+func changeableImmutableFields(ctx *ValidationContext) []string {
+	var fields []string
+	for _, f := range utils.ImmutableFields(ctx.Resource.Schema) {
+		if canChangeValue(ctx, ctx.Resource.Schema, f) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// canChangeValue reports whether changedSchemaValue can produce a value for
+// the property that differs from the current one. Only scalars are generated
+// with varying values; a reference can only point at the resource provisioned
+// for it, and an enum with a single value has no other value.
+func canChangeValue(ctx *ValidationContext, schema *openapi.Schema, field string) bool {
+	prop := schema.Properties[field]
+	switch prop.Type {
+	case "string", "integer", "boolean":
+	default:
+		return false
+	}
+	if utils.IsReference(prop) {
+		return false
+	}
+	return len(ctx.Generator.Enums.Values(schema, field)) != 1
+}
+
+// changedValue returns a value for a field of the resource that differs from
+// current.
+func changedValue(ctx *ValidationContext, field string, current interface{}) (interface{}, error) {
+	return changedSchemaValue(ctx, ctx.Resource.Schema, field, current)
+}
+
+// changedSchemaValue returns a value for a property of the schema that differs
+// from current, or an error if no such value can be generated.
+func changedSchemaValue(ctx *ValidationContext, schema *openapi.Schema, field string, current interface{}) (interface{}, error) {
+	if b, ok := current.(bool); ok {
+		return !b, nil
+	}
+	if values := ctx.Generator.Enums.Values(schema, field); len(values) > 0 {
+		for _, value := range values {
+			if fmt.Sprint(value) != fmt.Sprint(current) {
+				return value, nil
+			}
+		}
+		return nil, fmt.Errorf("field %q has no enum value other than %v", field, current)
+	}
+	for i := 0; i < 10; i++ {
+		value := ctx.Generator.GenerateFieldValue(schema, field)
+		if value != nil && fmt.Sprint(value) != fmt.Sprint(current) {
+			return value, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to generate a value for %q that differs from %v", field, current)
+}
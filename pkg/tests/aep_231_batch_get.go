@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+var TestAEP231BatchGet = Test{
+	Name:         "aep-231-batch-get",
+	URL:          "https://aep.dev/231",
+	Precondition: preconditionBatchMethod("batchGet"),
+	Setup:        setupBatchResources,
+	Run:          testBatchGet,
+	Teardown:     teardownDeleteAllResources,
+}
+
+func testBatchGet(v ValidationActions, ctx *ValidationContext) error {
+	// Step 1: Results are returned in the requested order.
+	var names []string
+	for i := len(ctx.Resources) - 1; i >= 0; i-- {
+		names = append(names, resourceName(ctx.Resources[i]))
+	}
+	status, results, err := readBatchResults(sendBatchPaths(v, ctx, "batchGet", names))
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("batchGet returned %d, expected 200", status)
+	}
+	if err := checkBatchOrder(names, results); err != nil {
+		return fmt.Errorf("batchGet: %w", err)
+	}
+	v.Logger().Println("   batchGet returned results in requested order.")
+
+	// Step 2: A missing resource fails the whole call.
+	missing := siblingName(names[0], v.GenerateID())
+	resp, err := sendBatchPaths(v, ctx, "batchGet", []string{names[0], missing})
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	if err := expectStatus(resp, http.StatusNotFound); err != nil {
+		return fmt.Errorf("batchGet with a missing resource: %w", err)
+	}
+	v.Logger().Println("   batchGet with a missing resource returned 404.")
+	return nil
+}
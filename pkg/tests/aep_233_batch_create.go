@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+var TestAEP233BatchCreate = Test{
+	Name:         "aep-233-batch-create",
+	URL:          "https://aep.dev/233",
+	Precondition: preconditionBatchMethod("batchCreate"),
+	Run:          testBatchCreate,
+	Teardown:     teardownDeleteAllResources,
+}
+
+// batchCreateRequest builds a batchCreate body with one request per payload.
+func batchCreateRequest(ctx *ValidationContext, payloads []map[string]interface{}) map[string]interface{} {
+	key := batchItemResourceKey(ctx, batchMethod(ctx, "batchCreate"))
+	requests := make([]interface{}, 0, len(payloads))
+	for _, p := range payloads {
+		item := map[string]interface{}{key: p}
+		if parent := parentPath(ctx); parent != "" {
+			item["parent"] = parent
+		}
+		requests = append(requests, item)
+	}
+	return map[string]interface{}{"requests": requests}
+}
+
+func testBatchCreate(v ValidationActions, ctx *ValidationContext) error {
+	// Step 1: Results are returned in the requested order.
+	var payloads []map[string]interface{}
+	for i := 0; i < 2; i++ {
+		p, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+		if err != nil {
+			return fmt.Errorf("failed to generate create payload: %w", err)
+		}
+		payloads = append(payloads, p)
+	}
+	status, results, err := readBatchResults(v.Post(batchURL(ctx, "batchCreate"), batchCreateRequest(ctx, payloads)))
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("batchCreate returned %d, expected 200", status)
+	}
+	ctx.Resources = append(ctx.Resources, results...)
+	if len(results) != len(payloads) {
+		return fmt.Errorf("batchCreate: expected %d results, got %d", len(payloads), len(results))
+	}
+	for i, p := range payloads {
+		if diffs := diffFields(p, results[i]); len(diffs) > 0 {
+			return fmt.Errorf("batchCreate result %d does not match request %d", i, i)
+		}
+	}
+	v.Logger().Println("   batchCreate returned results in requested order.")
+
+	// Step 2: One invalid request fails the whole batch.
+	required := requiredCreateFields(ctx)
+	if len(required) == 0 || ctx.Resource.Methods.List == nil {
+		v.Logger().Println("   No way to build an invalid request, skipping atomicity check.")
+		return nil
+	}
+	before, err := countResources(v, ctx)
+	if err != nil {
+		return err
+	}
+	valid, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to generate create payload: %w", err)
+	}
+	invalid, err := ctx.Generator.GenerateCreatePayload(ctx.Resource)
+	if err != nil {
+		return fmt.Errorf("failed to generate create payload: %w", err)
+	}
+	for _, f := range required {
+		delete(invalid, f)
+	}
+	status, results, err = readBatchResults(v.Post(batchURL(ctx, "batchCreate"), batchCreateRequest(ctx, []map[string]interface{}{valid, invalid})))
+	if err != nil {
+		return err
+	}
+	ctx.Resources = append(ctx.Resources, results...)
+	if err := expectBatchFailure("batchCreate with an invalid request", status); err != nil {
+		return err
+	}
+	after, err := countResources(v, ctx)
+	if err != nil {
+		return err
+	}
+	if after != before {
+		return fmt.Errorf("failed batchCreate was not atomic: collection grew by %d", after-before)
+	}
+	v.Logger().Println("   batchCreate with an invalid request failed atomically.")
+	return nil
+}
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+var TestAEP234BatchUpdate = Test{
+	Name:         "aep-234-batch-update",
+	URL:          "https://aep.dev/234",
+	Precondition: preconditionBatchUpdate,
+	Setup:        setupBatchResources,
+	Run:          testBatchUpdate,
+	Teardown:     teardownDeleteAllResources,
+}
+
+func preconditionBatchUpdate(ctx *ValidationContext) error {
+	if err := preconditionBatchMethod("batchUpdate")(ctx); err != nil {
+		return err
+	}
+	if len(mutableFields(ctx)) == 0 {
+		return fmt.Errorf("resource has no mutable fields")
+	}
+	return nil
+}
+
+// batchUpdateRequest builds a batchUpdate body updating each named resource.
+func batchUpdateRequest(ctx *ValidationContext, names []string, updates []map[string]interface{}) map[string]interface{} {
+	key := batchItemResourceKey(ctx, batchMethod(ctx, "batchUpdate"))
+	requests := make([]interface{}, 0, len(names))
+	for i, name := range names {
+		update := map[string]interface{}{"path": name}
+		for k, val := range updates[i] {
+			update[k] = val
+		}
+		requests = append(requests, map[string]interface{}{"path": name, key: update})
+	}
+	return map[string]interface{}{"requests": requests}
+}
+
+func testBatchUpdate(v ValidationActions, ctx *ValidationContext) error {
+	field := mutableFields(ctx)[0]
+
+	// Step 1: Results are returned in the requested order.
+	var names []string
+	var updates []map[string]interface{}
+	for i := len(ctx.Resources) - 1; i >= 0; i-- {
+		names = append(names, resourceName(ctx.Resources[i]))
+		update, err := changedValues(ctx, []string{field}, ctx.Resources[i])
+		if err != nil {
+			return err
+		}
+		updates = append(updates, update)
+	}
+	status, results, err := readBatchResults(v.Post(batchURL(ctx, "batchUpdate"), batchUpdateRequest(ctx, names, updates)))
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("batchUpdate returned %d, expected 200", status)
+	}
+	if err := checkBatchOrder(names, results); err != nil {
+		return fmt.Errorf("batchUpdate: %w", err)
+	}
+	for i, r := range results {
+		if diffs := diffFields(updates[i], r); len(diffs) > 0 {
+			return fmt.Errorf("batchUpdate result %d was not updated: %s", i, strings.Join(diffs, ", "))
+		}
+	}
+	v.Logger().Println("   batchUpdate returned results in requested order.")
+
+	// Step 2: An update of a missing resource fails the whole batch.
+	existing, err := v.Get(resourceURL(ctx, ctx.Resources[0]))
+	if err != nil {
+		return fmt.Errorf("failed to get resource: %w", err)
+	}
+	missing := siblingName(resourceName(existing), v.GenerateID())
+	names = []string{resourceName(existing), missing}
+	update, err := changedValues(ctx, []string{field}, existing)
+	if err != nil {
+		return err
+	}
+	updates = []map[string]interface{}{update, update}
+	status, _, err = readBatchResults(v.Post(batchURL(ctx, "batchUpdate"), batchUpdateRequest(ctx, names, updates)))
+	if err != nil {
+		return err
+	}
+	if err := expectBatchFailure("batchUpdate of a missing resource", status); err != nil {
+		return err
+	}
+	after, err := v.Get(resourceURL(ctx, existing))
+	if err != nil {
+		return fmt.Errorf("failed to get resource: %w", err)
+	}
+	if diffs := diffFields(map[string]interface{}{field: existing[field]}, after); len(diffs) > 0 {
+		return fmt.Errorf("failed batchUpdate was not atomic: %s was updated", resourceName(existing))
+	}
+	v.Logger().Println("   batchUpdate of a missing resource failed atomically.")
+	return nil
+}
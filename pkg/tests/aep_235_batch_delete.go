@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+var TestAEP235BatchDelete = Test{
+	Name:         "aep-235-batch-delete",
+	URL:          "https://aep.dev/235",
+	Precondition: preconditionBatchMethod("batchDelete"),
+	Setup:        setupBatchResources,
+	Run:          testBatchDelete,
+	Teardown:     teardownDeleteAllResources,
+}
+
+func testBatchDelete(v ValidationActions, ctx *ValidationContext) error {
+	var names []string
+	for _, r := range ctx.Resources {
+		names = append(names, resourceName(r))
+	}
+
+	// Step 1: A missing resource fails the whole batch.
+	withMissing := append([]string{siblingName(names[0], v.GenerateID())}, names...)
+	resp, err := sendBatchPaths(v, ctx, "batchDelete", withMissing)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	resp.Body.Close()
+	if err := expectBatchFailure("batchDelete with a missing resource", resp.StatusCode); err != nil {
+		return err
+	}
+	for _, r := range ctx.Resources {
+		if _, err := v.Get(resourceURL(ctx, r)); err != nil {
+			return fmt.Errorf("failed batchDelete was not atomic, %s is gone: %w", resourceName(r), err)
+		}
+	}
+	v.Logger().Println("   batchDelete with a missing resource failed atomically.")
+
+	// Step 2: Deleting existing resources removes all of them.
+	resp, err = sendBatchPaths(v, ctx, "batchDelete", names)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	if err := expectStatus(resp, http.StatusOK, http.StatusNoContent); err != nil {
+		return fmt.Errorf("batchDelete: %w", err)
+	}
+	deleted := ctx.Resources
+	ctx.Resources = nil
+	for _, r := range deleted {
+		// Soft-deleted resources remain retrievable, see aep.dev/164.
+		if softDeletes(ctx) {
+			if ctx.Resource.Methods.List != nil {
+				found, err := listContains(v, ctx, resourceName(r))
+				if err != nil {
+					return err
+				}
+				if found {
+					return fmt.Errorf("%s is still listed after batchDelete", resourceName(r))
+				}
+			}
+			if err := purgeSoftDeleted(v, ctx, resourceURL(ctx, r)); err != nil {
+				return err
+			}
+			continue
+		}
+		resp, err := v.GetReq(resourceURL(ctx, r))
+		if err != nil {
+			return fmt.Errorf("failed to make request: %w", err)
+		}
+		if err := expectStatus(resp, http.StatusNotFound); err != nil {
+			return fmt.Errorf("get %s after batchDelete: %w", resourceName(r), err)
+		}
+	}
+	v.Logger().Println("   batchDelete removed all resources.")
+	return nil
+}
@@ -0,0 +1,177 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/cases"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+)
+
+// batchMethodNames are the collection custom methods defined by aep.dev/231,
+// aep.dev/233, aep.dev/234 and aep.dev/235.
+var batchMethodNames = map[string]bool{
+	"batchGet":    true,
+	"batchCreate": true,
+	"batchUpdate": true,
+	"batchDelete": true,
+}
+
+// pathsBatchMethodNames are the batch methods whose request is a list of
+// resource paths, which may be declared with GET.
+var pathsBatchMethodNames = map[string]bool{
+	"batchGet":    true,
+	"batchDelete": true,
+}
+
+// batchMethod returns the batch method with the given name declared on the
+// collection, or nil if there is none.
+func batchMethod(ctx *ValidationContext, name string) *api.CustomMethod {
+	methods, err := utils.CollectionCustomMethods(ctx.Spec, ctx.Resource)
+	if err != nil {
+		return nil
+	}
+	for _, cm := range methods {
+		if cm.Name == name {
+			return cm
+		}
+	}
+	return nil
+}
+
+func preconditionBatchMethod(name string) func(*ValidationContext) error {
+	return func(ctx *ValidationContext) error {
+		cm := batchMethod(ctx, name)
+		if cm == nil {
+			return fmt.Errorf("collection does not declare %s", name)
+		}
+		if cm.IsLongRunning {
+			return fmt.Errorf("%s is long-running, which is not supported", name)
+		}
+		if cm.Method != "POST" && !pathsBatchMethodNames[name] {
+			return fmt.Errorf("%s is declared with %s, expected POST", name, cm.Method)
+		}
+		return nil
+	}
+}
+
+func batchURL(ctx *ValidationContext, name string) string {
+	return fmt.Sprintf("%s:%s", ctx.CollectionURL, name)
+}
+
+// sendBatchPaths calls a batch method whose request is a list of resource
+// paths, with the HTTP method the spec declares: the paths are sent as a query
+// parameter for GET and in the body otherwise.
+func sendBatchPaths(v ValidationActions, ctx *ValidationContext, name string, paths []string) (*http.Response, error) {
+	cm := batchMethod(ctx, name)
+	if cm != nil && cm.Method == "GET" {
+		op := utils.FindOperation(ctx.Spec, utils.CollectionPath(ctx.Resource)+":"+name, "GET")
+		param := utils.FindQueryParam(op, "paths", "names")
+		if param == "" {
+			param = "paths"
+		}
+		return v.GetReq(fmt.Sprintf("%s?%s", batchURL(ctx, name), url.Values{param: paths}.Encode()))
+	}
+	key := "paths"
+	if cm != nil && cm.Request != nil {
+		if _, ok := cm.Request.Properties["names"]; ok {
+			key = "names"
+		}
+	}
+	return v.Post(batchURL(ctx, name), map[string]interface{}{key: paths})
+}
+
+// setupBatchResources makes sure at least 2 resources exist.
+func setupBatchResources(v ValidationActions, ctx *ValidationContext) error {
+	for len(ctx.Resources) < 2 {
+		if err := testCreateResource(v, ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parentPath returns the path of the parent of the collection under test, or
+// "" for a top-level collection.
+func parentPath(ctx *ValidationContext) string {
+	collectionPath := strings.TrimPrefix(ctx.CollectionURL, ctx.Resource.API.ServerURL+"/")
+	if i := strings.LastIndex(collectionPath, "/"); i >= 0 {
+		return collectionPath[:i]
+	}
+	return ""
+}
+
+// batchItemResourceKey returns the property of a batch request item that holds
+// the resource, e.g. "book".
+func batchItemResourceKey(ctx *ValidationContext, cm *api.CustomMethod) string {
+	singular := cases.KebabToSnakeCase(ctx.Resource.Singular)
+	item := batchItemSchema(ctx, cm)
+	if item == nil {
+		return singular
+	}
+	for _, key := range []string{singular, "resource"} {
+		if _, ok := item.Properties[key]; ok {
+			return key
+		}
+	}
+	return singular
+}
+
+func batchItemSchema(ctx *ValidationContext, cm *api.CustomMethod) *openapi.Schema {
+	if cm.Request == nil || ctx.Spec == nil {
+		return nil
+	}
+	requests, ok := cm.Request.Properties["requests"]
+	if !ok || requests.Items == nil {
+		return nil
+	}
+	item, err := ctx.Spec.DereferenceSchema(*requests.Items)
+	if err != nil {
+		return nil
+	}
+	return item
+}
+
+// readBatchResults consumes a batch response and returns its status code and
+// results.
+func readBatchResults(resp *http.Response, err error) (int, []map[string]interface{}, error) {
+	status, raw, err := readResponse(resp, err)
+	if err != nil || status != http.StatusOK {
+		return status, nil, err
+	}
+	var results []map[string]interface{}
+	list, _ := raw["results"].([]interface{})
+	for _, item := range list {
+		if r, ok := item.(map[string]interface{}); ok {
+			results = append(results, r)
+		}
+	}
+	return status, results, nil
+}
+
+// expectBatchFailure returns an error unless status is a 4xx client error,
+// which is how a batch that must fail as a whole is rejected.
+func expectBatchFailure(name string, status int) error {
+	if status < 400 || status >= 500 {
+		return fmt.Errorf("%s returned %d, expected it to fail with a 4xx error", name, status)
+	}
+	return nil
+}
+
+// checkBatchOrder verifies the results are the resources with the given names,
+// in order.
+func checkBatchOrder(names []string, results []map[string]interface{}) error {
+	if len(results) != len(names) {
+		return fmt.Errorf("expected %d results, got %d", len(names), len(results))
+	}
+	for i, name := range names {
+		if got := resourceName(results[i]); got != name {
+			return fmt.Errorf("result %d is %s, expected %s", i, got, name)
+		}
+	}
+	return nil
+}
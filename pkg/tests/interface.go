@@ -3,28 +3,52 @@ package tests
 import (
 	"log"
 	"net/http"
+	"net/url"
 
 	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
 	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
 )
 
 type ValidationActions interface {
 	CreateResource(r *api.Resource, collectionURL string, payload map[string]interface{}) (map[string]interface{}, error)
 	List(url string) (*utils.ListResponse, error)
 	Post(url string, body interface{}) (*http.Response, error)
+	PostWithQuery(rawURL string, body interface{}, query url.Values) (*http.Response, error)
 	Patch(url string, body interface{}) (*http.Response, error)
+	PatchWithHeaders(url string, body interface{}, headers http.Header) (*http.Response, error)
+	Put(url string, body interface{}) (*http.Response, error)
 	Get(url string) (map[string]interface{}, error)
+	GetReq(url string) (*http.Response, error)
 	Delete(url string) error
 	DeleteReq(url string) (*http.Response, error)
+	DeleteReqWithHeaders(url string, headers http.Header) (*http.Response, error)
+	WaitOperation(op map[string]interface{}) (map[string]interface{}, error)
 	GenerateID() string
+	ObservedPaths() []ObservedPath
 	Logger() *log.Logger
 }
 
+// ObservedPath is a resource path found in a response from the API.
+type ObservedPath struct {
+	Path     string
+	Method   string
+	URL      string
+	Response string
+}
+
 type ValidationContext struct {
 	Resource      *api.Resource
+	Spec          *openapi.OpenAPI
 	CollectionURL string
 	Resources     []map[string]interface{}
+	Children      []map[string]interface{}
 	ListResponse1 *utils.ListResponse
+	// Generator generates the field values and payloads of requests.
+	Generator *utils.Generator
+	// InvokeCustomMethods allows POST custom methods, which may have side
+	// effects, to be invoked.
+	InvokeCustomMethods bool
 }
 
 type Test struct {
@@ -1,6 +1,8 @@
 package tests
 
 import (
+	"fmt"
+
 	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
 )
 
@@ -8,7 +10,7 @@ import (
 func setupListResources(v ValidationActions, ctx *ValidationContext) error {
 	// Create 3 resources to ensuring we have enough for 2 pages of size 1 and a 3rd page or just ensuring we have > 1.
 	for i := 0; i < 3; i++ {
-		resource, err := utils.CreateResource(v, ctx.Resource, ctx.CollectionURL)
+		resource, err := utils.CreateResource(v, ctx.Generator, ctx.Resource, ctx.CollectionURL)
 		if err != nil {
 			return err
 		}
@@ -16,3 +18,31 @@ func setupListResources(v ValidationActions, ctx *ValidationContext) error {
 	}
 	return nil
 }
+
+// listContains pages through the collection under test and reports whether a
+// resource with the given name is listed.
+func listContains(v ValidationActions, ctx *ValidationContext, name string) (bool, error) {
+	resource, err := findInList(v, ctx.CollectionURL, name)
+	return resource != nil, err
+}
+
+// findInList pages through a list URL and returns the listed resource with the
+// given name, or nil if it is not listed.
+func findInList(v ValidationActions, listURL string, name string) (map[string]interface{}, error) {
+	pageToken := ""
+	for {
+		listResp, err := utils.FetchList(v, listURL, pageToken, 0, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resources: %w", err)
+		}
+		for _, resource := range listResp.Resources {
+			if resourceName(resource) == name {
+				return resource, nil
+			}
+		}
+		pageToken = listResp.NextPageToken
+		if pageToken == "" {
+			return nil, nil
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+)
+
+// childCollectionURL returns the URL of a child collection under a parent
+// resource returned by the API.
+func childCollectionURL(ctx *ValidationContext, parent map[string]interface{}, child *api.Resource) string {
+	elems := child.PatternElems()
+	return fmt.Sprintf("%s/%s", resourceURL(ctx, parent), elems[len(elems)-2])
+}
+
+// listableChildren returns the child resource types of the resource that
+// support both create and list.
+func listableChildren(r *api.Resource) []*api.Resource {
+	var children []*api.Resource
+	for _, c := range creatableChildren(r) {
+		if c.Methods.List != nil {
+			children = append(children, c)
+		}
+	}
+	return children
+}
+
+// setupSiblingParents creates two resources in the collection under test to
+// act as parents, and one child of each listable child type under each.
+func setupSiblingParents(v ValidationActions, ctx *ValidationContext) error {
+	for i := 0; i < 2; i++ {
+		parent, err := utils.CreateResource(v, ctx.Generator, ctx.Resource, ctx.CollectionURL)
+		if err != nil {
+			return err
+		}
+		ctx.Resources = append(ctx.Resources, parent)
+		for _, child := range listableChildren(ctx.Resource) {
+			created, err := utils.CreateResource(v, ctx.Generator, child, childCollectionURL(ctx, parent, child))
+			if err != nil {
+				return fmt.Errorf("failed to create child %s: %w", child.Singular, err)
+			}
+			ctx.Children = append(ctx.Children, created)
+		}
+	}
+	return nil
+}
+
+// siblingParents returns the two parents created by setupSiblingParents.
+func siblingParents(ctx *ValidationContext) (map[string]interface{}, map[string]interface{}) {
+	n := len(ctx.Resources)
+	return ctx.Resources[n-2], ctx.Resources[n-1]
+}
+
+// childOf returns the child of the given type created under parent, or nil.
+func childOf(ctx *ValidationContext, parent map[string]interface{}, child *api.Resource) map[string]interface{} {
+	elems := child.PatternElems()
+	prefix := fmt.Sprintf("%s/%s/", resourceName(parent), elems[len(elems)-2])
+	for _, c := range ctx.Children {
+		if strings.HasPrefix(resourceName(c), prefix) {
+			return c
+		}
+	}
+	return nil
+}
+
+// teardownSiblingParents deletes the children before their parents.
+func teardownSiblingParents(v ValidationActions, ctx *ValidationContext) error {
+	for _, child := range ctx.Children {
+		if err := v.Delete(resourceURL(ctx, child)); err != nil && !strings.Contains(err.Error(), "status 404") {
+			return err
+		}
+	}
+	ctx.Children = nil
+	return teardownDeleteAllResources(v, ctx)
+}
@@ -0,0 +1,179 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
+)
+
+// resourceName returns the name of a resource, falling back to its path.
+func resourceName(resource map[string]interface{}) string {
+	rName, ok := resource["name"].(string)
+	if !ok || rName == "" {
+		rName, _ = resource["path"].(string)
+	}
+	return rName
+}
+
+// siblingName returns the name of a resource in the same collection as name,
+// with the given ID.
+func siblingName(name string, id string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[:i+1] + id
+	}
+	return id
+}
+
+// resourceURL returns the URL of a resource returned by the API.
+func resourceURL(ctx *ValidationContext, resource map[string]interface{}) string {
+	return fmt.Sprintf("%s/%s", ctx.Resource.API.ServerURL, resourceName(resource))
+}
+
+// createURL returns the URL to create a resource in the given collection,
+// including a fresh user-specified ID when the create method supports one.
+func createURL(v ValidationActions, ctx *ValidationContext, collectionURL string) string {
+	if idParam := utils.CreateIDParam(ctx.Spec, ctx.Resource); idParam != "" {
+		return fmt.Sprintf("%s?%s=%s", collectionURL, idParam, v.GenerateID())
+	}
+	return collectionURL
+}
+
+// withQuery appends a URL-encoded query parameter to rawURL.
+func withQuery(rawURL string, key string, value string) string {
+	sep := "?"
+	if strings.Contains(rawURL, "?") {
+		sep = "&"
+	}
+	return fmt.Sprintf("%s%s%s=%s", rawURL, sep, url.QueryEscape(key), url.QueryEscape(value))
+}
+
+// diffFields returns the sorted names of the fields in want whose values
+// differ in got.
+func diffFields(want, got map[string]interface{}) []string {
+	var diffs []string
+	for k, wantV := range want {
+		if !jsonEqual(wantV, got[k]) {
+			diffs = append(diffs, k)
+		}
+	}
+	sort.Strings(diffs)
+	return diffs
+}
+
+// jsonEqual reports whether two values have the same JSON encoding, so that
+// generated values compare equal to their decoded counterparts.
+func jsonEqual(a, b interface{}) bool {
+	aJSON, errA := json.Marshal(a)
+	bJSON, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return bytes.Equal(aJSON, bJSON)
+}
+
+// diffResources returns the sorted names of the fields that differ between
+// two resources, including fields only present in one of them.
+func diffResources(a, b map[string]interface{}) []string {
+	diffs := diffFields(a, b)
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			diffs = append(diffs, k)
+		}
+	}
+	sort.Strings(diffs)
+	return diffs
+}
+
+// isVolatileField reports whether the server is expected to change the field
+// on every mutation.
+func isVolatileField(name string) bool {
+	switch name {
+	case "update_time", "updateTime", "etag":
+		return true
+	}
+	return false
+}
+
+// readResponse consumes a response and returns its status code and, for a
+// 200 or 201 response, its decoded body.
+func readResponse(resp *http.Response, err error) (int, map[string]interface{}, error) {
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	var result map[string]interface{}
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		if err := json.Unmarshal(body, &result); err != nil {
+			return resp.StatusCode, nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+	return resp.StatusCode, result, nil
+}
+
+// awaitResult returns the resource in the response of a method. The response
+// of a long-running method must be an operation, which is waited on.
+func awaitResult(v ValidationActions, lro bool, result map[string]interface{}) (map[string]interface{}, error) {
+	if !lro {
+		return result, nil
+	}
+	if !utils.IsOperation(result) {
+		return nil, fmt.Errorf("expected an operation from long-running method, got %v", result)
+	}
+	return v.WaitOperation(result)
+}
+
+// expectStatus consumes the response body and returns an error if the status
+// code is not one of want.
+func expectStatus(resp *http.Response, want ...int) error {
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	for _, code := range want {
+		if resp.StatusCode == code {
+			return nil
+		}
+	}
+	return fmt.Errorf("expected status %v, got %d: %s", want, resp.StatusCode, string(body))
+}
+
+// expectErrorResponse consumes the response body and returns an error unless
+// the status code is want and the body is an aep.dev/193 error, i.e. a JSON
+// problem details object.
+func expectErrorResponse(resp *http.Response, want int) error {
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != want {
+		return fmt.Errorf("expected status %d, got %d: %s", want, resp.StatusCode, string(body))
+	}
+	var problem map[string]interface{}
+	if err := json.Unmarshal(body, &problem); err != nil {
+		return fmt.Errorf("expected an aep.dev/193 error body, got %q: %w", string(body), err)
+	}
+	if status, ok := problem["status"].(float64); ok && int(status) != want {
+		return fmt.Errorf("error body status %d does not match response status %d", int(status), want)
+	}
+	for _, field := range []string{"type", "title", "detail"} {
+		if _, ok := problem[field]; ok {
+			return nil
+		}
+	}
+	return fmt.Errorf("error body is missing type, title and detail: %s", string(body))
+}
+
+// teardownDeleteAllResources deletes every resource tracked in the context.
+func teardownDeleteAllResources(v ValidationActions, ctx *ValidationContext) error {
+	for len(ctx.Resources) > 0 {
+		if err := testDeleteResource(v, ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
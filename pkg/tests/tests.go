@@ -4,10 +4,42 @@ func NewTests() []Test {
 	return []Test{
 		TestAEP132ListResourcesLimit1,
 		TestAEP132ListResourcesPageToken,
+		TestAEP132ListOrderBy,
 		TestAEP133Create,
 		TestAEP133DuplicateCreationCheck,
+		TestAEP133CreateNonExistentParent,
+		TestAEP151LongRunningOperations,
+		TestAEP155RequestID,
+		TestAEP131GetNonExistentResource,
+		TestAEP157ReadMask,
+		TestAEP203CreateRequiredFields,
+		TestAEP203CreateReadOnlyFields,
+		TestAEP126EnumValues,
+		TestAEP122NonExistentReference,
 		TestAEP134UpdateResource,
+		TestAEP134UpdateClearField,
+		TestAEP134UpdateMask,
+		TestAEP134UpdateNonExistentResource,
+		TestAEP203ImmutableFields,
+		TestAEP154ETag,
+		TestAEP148StandardFields,
+		TestAEP137ApplyResource,
+		TestAEP137ApplyPathMismatch,
+		TestAEP136CustomMethods,
+		TestAEP231BatchGet,
+		TestAEP233BatchCreate,
+		TestAEP234BatchUpdate,
+		TestAEP235BatchDelete,
+		TestAEP122ParentIsolation,
+		TestAEP159ListAllParents,
+		TestAEP156Singleton,
+		TestAEP130UndeclaredMethods,
 		TestAEP135DeleteResource,
+		TestAEP135DeleteTwice,
+		TestAEP135DeleteForce,
+		TestAEP164SoftDelete,
 		TestAEP135DeleteNonExistentResource,
+		// Runs last, so that it checks the responses of every other test.
+		TestAEP122ResourcePaths,
 	}
 }
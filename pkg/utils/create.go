@@ -12,8 +12,8 @@ type Creator interface {
 	Logger() *log.Logger
 }
 
-func CreateResource(c Creator, r *api.Resource, collectionURL string) (map[string]interface{}, error) {
-	createPayload, err := GenerateCreatePayload(r)
+func CreateResource(c Creator, g *Generator, r *api.Resource, collectionURL string) (map[string]interface{}, error) {
+	createPayload, err := g.GenerateCreatePayload(r)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate create payload: %w", err)
 	}
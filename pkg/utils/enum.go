@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/cases"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+)
+
+// Enums holds the enum values of the top-level properties of each resource
+// schema. openapi.Schema does not decode "enum", so they are read from the raw
+// spec.
+type Enums map[*openapi.Schema]map[string][]interface{}
+
+type rawSpec struct {
+	Components struct {
+		Schemas map[string]rawSchema `json:"schemas"`
+	} `json:"components"`
+	Definitions map[string]rawSchema `json:"definitions"`
+}
+
+type rawSchema struct {
+	Ref          string               `json:"$ref"`
+	Enum         []interface{}        `json:"enum"`
+	Properties   map[string]rawSchema `json:"properties"`
+	XAEPResource *struct {
+		Singular string `json:"singular"`
+	} `json:"x-aep-resource"`
+}
+
+// ReadEnums reads the enum values of the properties of every resource in the
+// API from the raw spec, following $refs to shared enum schemas.
+func ReadEnums(raw []byte, a *api.API) (Enums, error) {
+	var spec rawSpec
+	if err := json.Unmarshal(raw, &spec); err != nil {
+		return nil, err
+	}
+	schemas := spec.Components.Schemas
+	if len(schemas) == 0 {
+		schemas = spec.Definitions
+	}
+	enums := make(Enums)
+	for _, r := range a.Resources {
+		for name, s := range schemas {
+			matches := name == cases.SnakeToPascalCase(cases.KebabToSnakeCase(r.Singular))
+			if s.XAEPResource != nil {
+				matches = s.XAEPResource.Singular == r.Singular
+			}
+			if !matches {
+				continue
+			}
+			values := make(map[string][]interface{})
+			for prop, p := range s.Properties {
+				if enum := enumValues(schemas, p); len(enum) > 0 {
+					values[prop] = enum
+				}
+			}
+			enums[r.Schema] = values
+		}
+	}
+	return enums, nil
+}
+
+// enumValues returns the enum values of a schema, resolving local $refs.
+func enumValues(schemas map[string]rawSchema, s rawSchema) []interface{} {
+	seen := make(map[string]bool)
+	for s.Ref != "" && !seen[s.Ref] {
+		seen[s.Ref] = true
+		ref, ok := schemas[s.Ref[strings.LastIndex(s.Ref, "/")+1:]]
+		if !ok {
+			return nil
+		}
+		s = ref
+	}
+	return s.Enum
+}
+
+// Values returns the declared enum values of a property of the schema, or nil
+// if the property is not an enum.
+func (e Enums) Values(schema *openapi.Schema, prop string) []interface{} {
+	return e[schema][prop]
+}
+
+// Fields returns the names of the enum properties of the schema.
+func (e Enums) Fields(schema *openapi.Schema) []string {
+	var fields []string
+	for prop := range e[schema] {
+		fields = append(fields, prop)
+	}
+	sort.Strings(fields)
+	return fields
+}
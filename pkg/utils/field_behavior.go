@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+)
+
+const FieldBehaviorImmutable = "IMMUTABLE"
+
+// HasFieldBehavior reports whether the schema's x-aep-field annotation declares
+// the given field behavior. Both the bare ("IMMUTABLE") and prefixed
+// ("FIELD_BEHAVIOR_IMMUTABLE") forms are accepted.
+func HasFieldBehavior(schema openapi.Schema, behavior string) bool {
+	if schema.XAEPField == nil {
+		return false
+	}
+	for _, b := range schema.XAEPField.Behavior {
+		if strings.TrimPrefix(strings.ToUpper(b), "FIELD_BEHAVIOR_") == behavior {
+			return true
+		}
+	}
+	return false
+}
+
+// ImmutableFields returns the sorted names of the properties that are marked
+// immutable and can be set by a client.
+func ImmutableFields(schema *openapi.Schema) []string {
+	var fields []string
+	for name, prop := range schema.Properties {
+		if prop.ReadOnly || isSystemField(name) {
+			continue
+		}
+		if HasFieldBehavior(prop, FieldBehaviorImmutable) {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -13,22 +13,47 @@ func init() {
     rand.Seed(time.Now().UnixNano())
 }
 
-func GenerateCreatePayload(r *api.Resource) (map[string]interface{}, error) {
-    payload := make(map[string]interface{})
+// Generator generates field values and request payloads for the resources of
+// an API. Its zero value generates random values of each property's type.
+type Generator struct {
+    // Enums holds the declared values of enum properties.
+    Enums Enums
+    // References holds the path of a provisioned instance of each referenced
+    // resource type, keyed by its singular name, so that generated payloads
+    // refer to resources that exist.
+    References map[string]string
+}
 
+func (g *Generator) GenerateCreatePayload(r *api.Resource) (map[string]interface{}, error) {
     if r.Schema == nil {
         return nil, fmt.Errorf("resource schema is nil")
     }
+    return g.GeneratePayload(r.Schema)
+}
+
+// GeneratePayload generates a request body for an object schema, such as the
+// request of a custom method.
+func (g *Generator) GeneratePayload(schema *openapi.Schema) (map[string]interface{}, error) {
+    payload := make(map[string]interface{})
+
+    if schema == nil {
+        return nil, fmt.Errorf("schema is nil")
+    }
 
     // Only populate required fields for now, or fields that seem important
-    for propName, propSchema := range r.Schema.Properties {
+    for propName, propSchema := range schema.Properties {
         if isSystemField(propName) {
             continue
         }
+        // A reference without a registered resource could not be
+        // provisioned, so it is left out rather than set to an invalid path.
+        if IsReference(propSchema) && g.referenceValue(propSchema) == "" {
+            continue
+        }
 
         // TODO: check if required, or just generate everything that isn't readOnly
         if !propSchema.ReadOnly {
-             payload[propName] = generateValue(propSchema)
+            payload[propName] = g.GenerateFieldValue(schema, propName)
         }
     }
 
@@ -59,3 +84,26 @@ func generateValue(schema openapi.Schema) interface{} {
     }
     return nil
 }
+
+// GenerateFieldValue returns a random value for a property of the schema,
+// picking one of the declared values for enums and the registered resource
+// for references.
+func (g *Generator) GenerateFieldValue(schema *openapi.Schema, prop string) interface{} {
+    if path := g.referenceValue(schema.Properties[prop]); path != "" {
+        return path
+    }
+    if values := g.Enums.Values(schema, prop); len(values) > 0 {
+        return values[rand.Intn(len(values))]
+    }
+    return generateValue(schema.Properties[prop])
+}
+
+// GenerateRequestID returns a random UUID4 suitable for an aep.dev/155
+// request_id.
+func GenerateRequestID() string {
+    b := make([]byte, 16)
+    rand.Read(b)
+    b[6] = (b[6] & 0x0f) | 0x40
+    b[8] = (b[8] & 0x3f) | 0x80
+    return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -14,7 +15,9 @@ type Lister interface {
 	List(url string) (*ListResponse, error)
 }
 
-func FetchList(lister Lister, baseURL string, pageToken string, maxPageSize int) (*ListResponse, error) {
+// FetchList lists a page of resources. Any extra query parameters, such as
+// order_by, are sent alongside the page token and page size.
+func FetchList(lister Lister, baseURL string, pageToken string, maxPageSize int, query url.Values) (*ListResponse, error) {
 	var params []string
 	if pageToken != "" {
 		params = append(params, fmt.Sprintf("page_token=%s", pageToken))
@@ -22,6 +25,9 @@ func FetchList(lister Lister, baseURL string, pageToken string, maxPageSize int)
 	if maxPageSize > 0 {
 		params = append(params, fmt.Sprintf("max_page_size=%d", maxPageSize))
 	}
+	if len(query) > 0 {
+		params = append(params, query.Encode())
+	}
 
 	url := baseURL
 	if len(params) > 0 {
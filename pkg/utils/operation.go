@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+)
+
+type Getter interface {
+	Get(url string) (map[string]interface{}, error)
+}
+
+// IsLongRunning reports whether the operation at the given path template and
+// method returns an aep.dev/151 Operation, either because it is annotated with
+// x-aep-long-running-operation or because its response schema is an Operation.
+func IsLongRunning(doc *openapi.OpenAPI, path string, method string) bool {
+	op := FindOperation(doc, path, method)
+	if op == nil {
+		return false
+	}
+	if op.XAEPLongRunningOperation != nil {
+		return true
+	}
+	for _, code := range []string{"200", "201", "202"} {
+		resp, ok := op.Responses[code]
+		if !ok {
+			continue
+		}
+		if schema := doc.GetSchemaFromResponse(resp, openapi.APPLICATION_JSON); schema != nil && strings.HasSuffix(schema.Ref, "/Operation") {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOperation reports whether a decoded response body is an Operation.
+func IsOperation(body map[string]interface{}) bool {
+	_, hasDone := body["done"].(bool)
+	return hasDone && OperationPath(body) != ""
+}
+
+// OperationPath returns the path of an Operation, falling back to its name.
+func OperationPath(op map[string]interface{}) string {
+	path, ok := op["path"].(string)
+	if !ok || path == "" {
+		path, _ = op["name"].(string)
+	}
+	return path
+}
+
+// PollOperation gets the operation every interval until it is done, returning
+// the final operation. It fails if the operation is not done within timeout.
+func PollOperation(g Getter, serverURL string, op map[string]interface{}, interval, timeout time.Duration) (map[string]interface{}, error) {
+	deadline := time.Now().Add(timeout)
+	opURL := fmt.Sprintf("%s/%s", serverURL, OperationPath(op))
+	for {
+		if done, _ := op["done"].(bool); done {
+			return op, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("operation %s not done after %s", OperationPath(op), timeout)
+		}
+		time.Sleep(interval)
+		next, err := g.Get(opURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get operation %s: %w", OperationPath(op), err)
+		}
+		op = next
+	}
+}
+
+// OperationError returns the error of a done Operation, if it failed.
+func OperationError(op map[string]interface{}) error {
+	if opErr, ok := op["error"]; ok && opErr != nil {
+		return fmt.Errorf("operation %s failed: %v", OperationPath(op), opErr)
+	}
+	return nil
+}
+
+// OperationResult unwraps a done Operation, returning its response or its
+// error. It fails if the operation has neither.
+func OperationResult(op map[string]interface{}) (map[string]interface{}, error) {
+	if err := OperationError(op); err != nil {
+		return nil, err
+	}
+	response, ok := op["response"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("operation %s is done but has no response", OperationPath(op))
+	}
+	return response, nil
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+)
+
+// referenceTypes returns the singular names of the resource types a property
+// refers to through x-aep-field.resource_reference. Fully qualified types such
+// as "library.example.com/book" are reduced to their singular name.
+func referenceTypes(prop openapi.Schema) []string {
+	if prop.XAEPField == nil {
+		return nil
+	}
+	var types []string
+	for _, ref := range prop.XAEPField.ResourceReference {
+		if ref == "*" {
+			continue
+		}
+		types = append(types, ref[strings.LastIndex(ref, "/")+1:])
+	}
+	return types
+}
+
+// IsReference reports whether a property refers to another resource.
+func IsReference(prop openapi.Schema) bool {
+	return prop.XAEPField != nil && len(prop.XAEPField.ResourceReference) > 0
+}
+
+// ReferencedResource returns the resource type a property of r refers to, or
+// nil if the property is not a reference to a resource in the API.
+func ReferencedResource(r *api.Resource, prop string) *api.Resource {
+	for _, t := range referenceTypes(r.Schema.Properties[prop]) {
+		if ref, ok := r.API.Resources[t]; ok {
+			return ref
+		}
+	}
+	return nil
+}
+
+// ReferenceFields returns the sorted names of the properties of r that a
+// client can set to a reference to another resource in the API.
+func ReferenceFields(r *api.Resource) []string {
+	var fields []string
+	for name, prop := range r.Schema.Properties {
+		if prop.ReadOnly || isSystemField(name) {
+			continue
+		}
+		if ReferencedResource(r, name) != nil {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// RegisterReference records path as the instance of r to use for generated
+// references to r.
+func (g *Generator) RegisterReference(r *api.Resource, path string) {
+	if g.References == nil {
+		g.References = make(map[string]string)
+	}
+	g.References[r.Singular] = path
+}
+
+// ReferencePath returns the registered instance of r, or "" if none.
+func (g *Generator) ReferencePath(r *api.Resource) string {
+	return g.References[r.Singular]
+}
+
+// referenceValue returns the registered path for a reference property, or ""
+// if the property is not a reference or nothing is registered.
+func (g *Generator) referenceValue(prop openapi.Schema) string {
+	for _, t := range referenceTypes(prop) {
+		if path, ok := g.References[t]; ok {
+			return path
+		}
+	}
+	return ""
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+)
+
+// ValidateAgainstSchema checks a decoded JSON value against a schema and
+// returns a description of every mismatch. Only types, properties, items and
+// required fields are checked.
+func ValidateAgainstSchema(value interface{}, schema *openapi.Schema) []string {
+	return validateValue("$", value, schema)
+}
+
+func validateValue(path string, value interface{}, schema *openapi.Schema) []string {
+	if schema == nil || value == nil {
+		return nil
+	}
+	var errs []string
+	switch schema.Type {
+	case "string":
+		if _, ok := value.(string); !ok {
+			errs = append(errs, fmt.Sprintf("%s: expected string, got %T", path, value))
+		}
+	case "integer":
+		if f, ok := value.(float64); !ok || f != float64(int64(f)) {
+			errs = append(errs, fmt.Sprintf("%s: expected integer, got %v", path, value))
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			errs = append(errs, fmt.Sprintf("%s: expected number, got %T", path, value))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			errs = append(errs, fmt.Sprintf("%s: expected boolean, got %T", path, value))
+		}
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return append(errs, fmt.Sprintf("%s: expected array, got %T", path, value))
+		}
+		for i, item := range items {
+			errs = append(errs, validateValue(fmt.Sprintf("%s[%d]", path, i), item, schema.Items)...)
+		}
+	case "object", "":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			if schema.Type == "object" {
+				errs = append(errs, fmt.Sprintf("%s: expected object, got %T", path, value))
+			}
+			return errs
+		}
+		for _, name := range schema.Required {
+			if _, ok := obj[name]; !ok {
+				errs = append(errs, fmt.Sprintf("%s.%s: required field missing", path, name))
+			}
+		}
+		names := make([]string, 0, len(schema.Properties))
+		for name := range schema.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			prop := schema.Properties[name]
+			errs = append(errs, validateValue(path+"."+name, obj[name], &prop)...)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+)
+
+// Singleton is a singleton sub-resource of a parent (aep.dev/156), such as
+// "shelves/{shelf_id}/config". aep-lib-go only models resources in
+// collections, so singletons are read from the spec directly.
+type Singleton struct {
+	// Name is the last segment of the singleton's path, e.g. "config".
+	Name   string
+	Schema *openapi.Schema
+	// Update reports whether the spec declares an update method.
+	Update bool
+}
+
+// Singletons returns the singletons declared under the resource r, sorted by
+// name. A singleton is a literal path segment after the resource path that is
+// not the collection of a child resource and has a get method.
+func Singletons(doc *openapi.OpenAPI, r *api.Resource) ([]*Singleton, error) {
+	if doc == nil {
+		return nil, nil
+	}
+	collections := make(map[string]bool)
+	for _, c := range r.Children {
+		if c == nil {
+			continue
+		}
+		elems := c.PatternElems()
+		collections[elems[len(elems)-2]] = true
+	}
+
+	prefix := ResourcePath(r) + "/"
+	var singletons []*Singleton
+	for path, pathItem := range doc.Paths {
+		name := strings.TrimPrefix(path, prefix)
+		if !strings.HasPrefix(path, prefix) || strings.ContainsAny(name, "/{:") || collections[name] {
+			continue
+		}
+		if pathItem == nil || pathItem.Get == nil {
+			continue
+		}
+		resp, ok := pathItem.Get.Responses["200"]
+		if !ok {
+			continue
+		}
+		ref := doc.GetSchemaFromResponse(resp, openapi.APPLICATION_JSON)
+		if ref == nil {
+			continue
+		}
+		schema, err := doc.DereferenceSchema(*ref)
+		if err != nil {
+			return nil, fmt.Errorf("error dereferencing response of %s: %w", path, err)
+		}
+		if _, isList := schema.Properties["results"]; isList {
+			continue
+		}
+		singletons = append(singletons, &Singleton{
+			Name:   name,
+			Schema: schema,
+			Update: pathItem.Patch != nil,
+		})
+	}
+	sort.Slice(singletons, func(i, j int) bool { return singletons[i].Name < singletons[j].Name })
+	return singletons, nil
+}
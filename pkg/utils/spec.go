@@ -0,0 +1,196 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/cases"
+	"github.com/aep-dev/aep-lib-go/pkg/constants"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
+)
+
+// LoadSpec reads and decodes the OpenAPI spec at a file path or URL, and also
+// returns the raw document for the parts openapi.OpenAPI does not decode, such
+// as enums. openapi.FetchOpenAPI does not expose the raw document, so this
+// replaces it.
+func LoadSpec(pathOrURL string) (*openapi.OpenAPI, []byte, error) {
+	var raw []byte
+	var err error
+	if u, parseErr := url.Parse(pathOrURL); parseErr == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		var resp *http.Response
+		if resp, err = http.Get(pathOrURL); err == nil {
+			defer resp.Body.Close()
+			raw, err = io.ReadAll(resp.Body)
+		}
+	} else {
+		raw, err = os.ReadFile(pathOrURL)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to read file or URL: %w", err)
+	}
+	var doc openapi.OpenAPI
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, nil, err
+	}
+	return &doc, raw, nil
+}
+
+// CollectionPath returns the OpenAPI path template of the resource's collection,
+// e.g. "/shelves/{shelf_id}/books".
+func CollectionPath(r *api.Resource) string {
+	elems := r.PatternElems()
+	return "/" + strings.Join(elems[:len(elems)-1], "/")
+}
+
+// ResourcePath returns the OpenAPI path template of an individual resource,
+// e.g. "/shelves/{shelf_id}/books/{book_id}".
+func ResourcePath(r *api.Resource) string {
+	return "/" + r.GetPattern()
+}
+
+// FindOperation looks up the operation for the given path template and HTTP
+// method in the spec. It returns nil if the spec or the operation is missing.
+func FindOperation(doc *openapi.OpenAPI, path string, method string) *openapi.Operation {
+	if doc == nil {
+		return nil
+	}
+	pathItem, ok := doc.Paths[path]
+	if !ok || pathItem == nil {
+		return nil
+	}
+	switch strings.ToUpper(method) {
+	case "GET":
+		return pathItem.Get
+	case "POST":
+		return pathItem.Post
+	case "PATCH":
+		return pathItem.Patch
+	case "PUT":
+		return pathItem.Put
+	case "DELETE":
+		return pathItem.Delete
+	}
+	return nil
+}
+
+// FindQueryParam returns the name of the first query parameter of op that
+// matches one of the candidate names, or "" if none do.
+func FindQueryParam(op *openapi.Operation, candidates ...string) string {
+	if op == nil {
+		return ""
+	}
+	for _, c := range candidates {
+		for _, p := range op.Parameters {
+			if p.Name == c && (p.In == "" || p.In == "query") {
+				return p.Name
+			}
+		}
+	}
+	return ""
+}
+
+// CreateIDParam returns the name of the query parameter used to set a
+// user-specified ID on create, as declared by the create operation in the spec.
+// It falls back to "id" (aep.dev/133) when the spec does not declare the
+// operation but the resource supports user-settable IDs, and returns "" when
+// the create method does not accept a user-specified ID.
+func CreateIDParam(doc *openapi.OpenAPI, r *api.Resource) string {
+	if r.Methods.Create == nil {
+		return ""
+	}
+	op := FindOperation(doc, CollectionPath(r), "POST")
+	if name := FindQueryParam(op, constants.FIELD_ID_NAME, fmt.Sprintf("%s_id", cases.KebabToSnakeCase(r.Singular))); name != "" {
+		return name
+	}
+	if r.Methods.Create.SupportsUserSettableCreate {
+		return constants.FIELD_ID_NAME
+	}
+	return ""
+}
+
+// CollectionCustomMethods returns the custom methods declared on the
+// resource's collection path (e.g. "/books:import"). aep-lib-go only attaches
+// resource-scoped custom methods to a resource, so these are read from the
+// spec directly.
+func CollectionCustomMethods(doc *openapi.OpenAPI, r *api.Resource) ([]*api.CustomMethod, error) {
+	if doc == nil {
+		return nil, nil
+	}
+	prefix := CollectionPath(r) + ":"
+	var paths []string
+	for path := range doc.Paths {
+		if strings.HasPrefix(path, prefix) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var methods []*api.CustomMethod
+	for _, path := range paths {
+		name := strings.TrimPrefix(path, prefix)
+		pathItem := doc.Paths[path]
+		for _, m := range []struct {
+			method string
+			op     *openapi.Operation
+		}{{"POST", pathItem.Post}, {"GET", pathItem.Get}} {
+			if m.op == nil {
+				continue
+			}
+			cm := &api.CustomMethod{
+				Name:          name,
+				Method:        m.method,
+				Response:      &openapi.Schema{},
+				IsLongRunning: m.op.XAEPLongRunningOperation != nil,
+			}
+			if resp, ok := m.op.Responses["200"]; ok {
+				if schema := doc.GetSchemaFromResponse(resp, openapi.APPLICATION_JSON); schema != nil {
+					s, err := doc.DereferenceSchema(*schema)
+					if err != nil {
+						return nil, fmt.Errorf("error dereferencing response of %s: %w", path, err)
+					}
+					cm.Response = s
+				}
+			}
+			if m.op.RequestBody != nil {
+				if schema := doc.GetSchemaFromRequestBody(*m.op.RequestBody, openapi.APPLICATION_JSON); schema != nil {
+					s, err := doc.DereferenceSchema(*schema)
+					if err != nil {
+						return nil, fmt.Errorf("error dereferencing request of %s: %w", path, err)
+					}
+					cm.Request = s
+				}
+			}
+			methods = append(methods, cm)
+		}
+	}
+	return methods, nil
+}
+
+// MatchesPattern reports whether a resource path such as "shelves/1/books/2"
+// matches pattern elements such as ["shelves", "{shelf_id}", "books", "{book_id}"].
+// Variable segments match any non-empty segment.
+func MatchesPattern(path string, patternElems []string) bool {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) != len(patternElems) {
+		return false
+	}
+	for i, elem := range patternElems {
+		if segments[i] == "" {
+			return false
+		}
+		if strings.HasPrefix(elem, "{") && strings.HasSuffix(elem, "}") {
+			continue
+		}
+		if segments[i] != elem {
+			return false
+		}
+	}
+	return true
+}
@@ -7,6 +7,9 @@ import (
 	"log"
 	"net/http"
 	"strings"
+
+	"github.com/aep-dev/aep-e2e-validator/pkg/tests"
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
 )
 
 type Header struct {
@@ -28,6 +31,9 @@ type extendedClient struct {
 	inner   *http.Client
 	headers []Header
 	logs    []RequestLog
+	// history holds every request made since it was last cleared, unlike logs
+	// which only holds the requests of the current test.
+	history []RequestLog
 	logger  *log.Logger
 }
 
@@ -35,6 +41,52 @@ func (c *extendedClient) clearLogs() {
 	c.logs = nil
 }
 
+func (c *extendedClient) clearHistory() {
+	c.history = nil
+}
+
+// observedPaths returns the resource paths found in the successful JSON
+// responses in the history, including those of list results.
+func (c *extendedClient) observedPaths() []tests.ObservedPath {
+	var observed []tests.ObservedPath
+	for _, l := range c.history {
+		if l.RespCode < 200 || l.RespCode >= 300 || !strings.Contains(strings.ToLower(l.RespType), "json") {
+			continue
+		}
+		var body map[string]interface{}
+		if err := json.Unmarshal([]byte(l.RespBody), &body); err != nil {
+			continue
+		}
+		// An operation is not a resource, but the response of a finished one is.
+		if utils.IsOperation(body) {
+			response, ok := body["response"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			body = response
+		}
+		candidates := []interface{}{body}
+		if results, ok := body["results"].([]interface{}); ok {
+			candidates = results
+		}
+		for _, candidate := range candidates {
+			resource, ok := candidate.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			path, ok := resource["path"].(string)
+			if !ok || path == "" {
+				path, _ = resource["name"].(string)
+			}
+			if path == "" {
+				continue
+			}
+			observed = append(observed, tests.ObservedPath{Path: path, Method: l.Method, URL: l.URL, Response: l.RespBody})
+		}
+	}
+	return observed
+}
+
 func prettyPrintBody(body string, contentType string) string {
 	trimmed := strings.TrimSpace(body)
 	if trimmed == "" {
@@ -101,7 +153,7 @@ func (c *extendedClient) Do(req *http.Request) (*http.Response, error) {
 		}
 	}
 
-	c.logs = append(c.logs, RequestLog{
+	entry := RequestLog{
 		Method:   req.Method,
 		URL:      req.URL.String(),
 		ReqBody:  reqBody,
@@ -109,7 +161,9 @@ func (c *extendedClient) Do(req *http.Request) (*http.Response, error) {
 		RespCode: respCode,
 		RespBody: respBody,
 		RespType: respType,
-	})
+	}
+	c.logs = append(c.logs, entry)
+	c.history = append(c.history, entry)
 
 	return resp, err
 }
@@ -8,6 +8,7 @@ import (
 	"log"
 	"math/rand"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -27,8 +28,19 @@ type Validator struct {
 	client         *extendedClient
 	jsonOutput     bool
 	logger         *log.Logger
+	spec           *openapi.OpenAPI
+	serverURL      string
+	pollInterval   time.Duration
+	pollTimeout    time.Duration
+	invokeCustom   bool
+	enums          utils.Enums
 }
 
+const (
+	defaultOperationPollInterval = time.Second
+	defaultOperationTimeout      = 2 * time.Minute
+)
+
 func NewValidator(configPath, collection string, allCollections bool, parent string, tests []string, headers []Header, jsonOutput bool) *Validator {
 	var output io.Writer = os.Stdout
 	if jsonOutput {
@@ -44,9 +56,24 @@ func NewValidator(configPath, collection string, allCollections bool, parent str
 		client:         &extendedClient{inner: &http.Client{}, headers: headers, logger: logger},
 		jsonOutput:     jsonOutput,
 		logger:         logger,
+		pollInterval:   defaultOperationPollInterval,
+		pollTimeout:    defaultOperationTimeout,
 	}
 }
 
+// SetOperationPolling configures how often long-running operations are polled
+// and how long to wait for them to complete.
+func (v *Validator) SetOperationPolling(interval, timeout time.Duration) {
+	v.pollInterval = interval
+	v.pollTimeout = timeout
+}
+
+// SetInvokeCustomMethods allows POST custom methods to be invoked. They may
+// have side effects, so they are skipped by default.
+func (v *Validator) SetInvokeCustomMethods(invoke bool) {
+	v.invokeCustom = invoke
+}
+
 func (v *Validator) Logger() *log.Logger {
 	return v.logger
 }
@@ -54,11 +81,12 @@ func (v *Validator) Logger() *log.Logger {
 func (v *Validator) Run() int {
 	start := time.Now()
 
-	doc, err := openapi.FetchOpenAPI(v.configPath)
+	doc, raw, err := utils.LoadSpec(v.configPath)
 	if err != nil {
 		log.Printf("failed to fetch OpenAPI spec: %v", err)
 		return ExitCodePreconditionFailed // Or some other code for setup failure
 	}
+	v.spec = doc
 
 	serverURL := ""
 	if len(doc.Servers) > 0 {
@@ -75,6 +103,12 @@ func (v *Validator) Run() int {
 		log.Printf("failed to validate API: %v", err)
 		return ExitCodePreconditionFailed
 	}
+	v.serverURL = aepAPI.ServerURL
+
+	if v.enums, err = utils.ReadEnums(raw, aepAPI); err != nil {
+		log.Printf("failed to read enum values: %v", err)
+		return ExitCodePreconditionFailed
+	}
 
 	var allResults []TestResult
 
@@ -95,6 +129,10 @@ func (v *Validator) Run() int {
 			}
 		}
 		if targetResource == nil {
+			if parent := singletonParent(doc, aepAPI, v.collection); parent != nil {
+				log.Printf("%s is a singleton, not a collection; its tests run when validating %s", v.collection, parent.Plural)
+				return ExitCodePreconditionFailed
+			}
 			log.Printf("collection %s not found in API", v.collection)
 			return ExitCodePreconditionFailed
 		}
@@ -110,6 +148,20 @@ func (v *Validator) Run() int {
 	return worstExitCode(allResults)
 }
 
+// singletonParent returns the resource that declares a singleton with the
+// given name, or nil if there is none.
+func singletonParent(doc *openapi.OpenAPI, a *api.API, name string) *api.Resource {
+	for _, r := range a.Resources {
+		singletons, _ := utils.Singletons(doc, r)
+		for _, s := range singletons {
+			if s.Name == name {
+				return r
+			}
+		}
+	}
+	return nil
+}
+
 func (v *Validator) collectionURL(r *api.Resource) string {
 	if v.parent != "" {
 		return fmt.Sprintf("%s/%s/%s", r.API.ServerURL, v.parent, r.Plural)
@@ -120,11 +172,16 @@ func (v *Validator) collectionURL(r *api.Resource) string {
 func (v *Validator) validateResource(r *api.Resource) []TestResult {
 	v.logger.Printf("Starting validation for resource: %s\n", r.Singular)
 	ctx := &tests.ValidationContext{
-		Resource:      r,
-		CollectionURL: v.collectionURL(r),
-		Resources:     make([]map[string]interface{}, 0),
+		Resource:            r,
+		Spec:                v.spec,
+		CollectionURL:       v.collectionURL(r),
+		Resources:           make([]map[string]interface{}, 0),
+		Generator:           &utils.Generator{Enums: v.enums},
+		InvokeCustomMethods: v.invokeCustom,
 	}
 
+	v.client.clearHistory()
+
 	availableTests := tests.NewTests()
 	var testsToRun []tests.Test
 
@@ -148,11 +205,15 @@ func (v *Validator) validateResource(r *api.Resource) []TestResult {
 	v.logger.Println("Running Global Setup...")
 	if err := v.cleanupCollection(r); err != nil {
 		v.logger.Printf("   Global Setup failed: %v\n", err)
-		results := make([]TestResult, len(testsToRun))
-		for i, t := range testsToRun {
-			results[i] = TestResult{Name: t.Name, URL: t.URL, Status: StatusError, Detail: fmt.Sprintf("global setup failed: %v", err)}
-		}
-		return results
+		return globalSetupErrors(testsToRun, err)
+	}
+
+	references, err := v.provisionReferences(ctx.Generator, r)
+	if err != nil {
+		err = fmt.Errorf("failed to create referenced resources: %w", err)
+		v.logger.Printf("   Global Setup failed: %v\n", err)
+		v.cleanupReferences(references)
+		return globalSetupErrors(testsToRun, err)
 	}
 
 	var results []TestResult
@@ -209,7 +270,18 @@ func (v *Validator) validateResource(r *api.Resource) []TestResult {
 	if err := v.cleanupCollection(r); err != nil {
 		v.logger.Printf("   Global Teardown failed: %v\n", err)
 	}
+	v.cleanupReferences(references)
+
+	return results
+}
 
+// globalSetupErrors returns an error result for each test, as none of them can
+// run when the global setup failed.
+func globalSetupErrors(testsToRun []tests.Test, err error) []TestResult {
+	results := make([]TestResult, len(testsToRun))
+	for i, t := range testsToRun {
+		results[i] = TestResult{Name: t.Name, URL: t.URL, Status: StatusError, Detail: fmt.Sprintf("global setup failed: %v", err)}
+	}
 	return results
 }
 
@@ -218,7 +290,7 @@ func (v *Validator) cleanupCollection(r *api.Resource) error {
 	pageToken := ""
 
 	for {
-		listResp, err := utils.FetchList(v, collectionURL, pageToken, 0)
+		listResp, err := utils.FetchList(v, collectionURL, pageToken, 0, nil)
 		if err != nil {
 			// If 404, the collection likely doesn't exist, which is fine (empty).
 			// But List should return 200 with 0 items usually.
@@ -255,22 +327,109 @@ func (v *Validator) cleanupCollection(r *api.Resource) error {
 	return nil
 }
 
+// provisionReferences creates an instance of every resource type referenced by
+// r, along with their own references and parents, and registers it so that
+// generated payloads refer to existing resources. A reference that can not be
+// provisioned is left out of generated payloads, and is only an error when the
+// field is required. It returns the created resources in the order they were
+// created.
+func (v *Validator) provisionReferences(g *utils.Generator, r *api.Resource) ([]map[string]interface{}, error) {
+	var created []map[string]interface{}
+	visiting := make(map[string]bool)
+	for _, field := range utils.ReferenceFields(r) {
+		if err := v.provisionReferenceField(g, r, r, field, visiting, &created); err != nil {
+			return created, err
+		}
+	}
+	return created, nil
+}
+
+// provisionReferenceField provisions the resource referenced by a field of r,
+// where under is the resource under test.
+func (v *Validator) provisionReferenceField(g *utils.Generator, under *api.Resource, r *api.Resource, field string, visiting map[string]bool, created *[]map[string]interface{}) error {
+	ref := utils.ReferencedResource(r, field)
+	var err error
+	if ref == under {
+		// Instances of the resource under test are only created by the tests.
+		err = fmt.Errorf("it refers to %s, the resource under test", under.Singular)
+	} else {
+		err = v.provisionReference(g, under, ref, visiting, created)
+	}
+	if err == nil {
+		return nil
+	}
+	for _, name := range r.Schema.Required {
+		if name == field {
+			return fmt.Errorf("required field %q of %s can not be set: %w", field, r.Singular, err)
+		}
+	}
+	v.logger.Printf("   Leaving field %q of %s unset: %v\n", field, r.Singular, err)
+	return nil
+}
+
+func (v *Validator) provisionReference(g *utils.Generator, under *api.Resource, r *api.Resource, visiting map[string]bool, created *[]map[string]interface{}) error {
+	if g.ReferencePath(r) != "" {
+		return nil
+	}
+	if visiting[r.Singular] {
+		return fmt.Errorf("%s is part of a reference cycle", r.Singular)
+	}
+	if r.Methods.Create == nil {
+		return fmt.Errorf("%s does not support create", r.Singular)
+	}
+	visiting[r.Singular] = true
+	defer delete(visiting, r.Singular)
+
+	elems := r.PatternElems()
+	collectionURL := fmt.Sprintf("%s/%s", r.API.ServerURL, elems[len(elems)-2])
+	if parents := r.ParentResources(); len(parents) > 0 {
+		parent := parents[0]
+		if parent == under {
+			return fmt.Errorf("%s is a child of %s, the resource under test", r.Singular, under.Singular)
+		}
+		if err := v.provisionReference(g, under, parent, visiting, created); err != nil {
+			return err
+		}
+		collectionURL = fmt.Sprintf("%s/%s/%s", r.API.ServerURL, g.ReferencePath(parent), elems[len(elems)-2])
+	}
+	for _, field := range utils.ReferenceFields(r) {
+		if err := v.provisionReferenceField(g, under, r, field, visiting, created); err != nil {
+			return err
+		}
+	}
+
+	resource, err := utils.CreateResource(v, g, r, collectionURL)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", r.Singular, err)
+	}
+	*created = append(*created, resource)
+	rName, ok := resource["name"].(string)
+	if !ok || rName == "" {
+		rName, _ = resource["path"].(string)
+	}
+	g.RegisterReference(r, rName)
+	return nil
+}
+
+// cleanupReferences deletes the resources created by provisionReferences in
+// reverse order.
+func (v *Validator) cleanupReferences(references []map[string]interface{}) {
+	for i := len(references) - 1; i >= 0; i-- {
+		rName, ok := references[i]["name"].(string)
+		if !ok || rName == "" {
+			rName, _ = references[i]["path"].(string)
+		}
+		if err := v.Delete(fmt.Sprintf("%s/%s", v.serverURL, rName)); err != nil && !strings.Contains(err.Error(), "status 404") {
+			v.logger.Printf("   Warning: failed to delete referenced resource %s: %v\n", rName, err)
+		}
+	}
+}
+
 func (v *Validator) CreateResource(r *api.Resource, collectionURL string, payload map[string]interface{}) (map[string]interface{}, error) {
 	// If UserSettableID is supported, generate one
 	var urlToUse = collectionURL
-	if r.Methods.Create != nil && r.Methods.Create.SupportsUserSettableCreate {
-		// Wait, multiple calls need different IDs. Random is better.
-		// Generator.go doesn't expose random helper directly but we can use simple random here.
-		// Let's rely on server assigned if not strictly testing UserSettable.
-		// But for Step 2 (duplicate check), we needed it.
-		// Let's append ID param.
-
-		// Actually, let's just let server assign if we don't strictly need to control it
-		// UNLESS we need to test duplicate creation.
-		// For now, appending ID query param if supported to be safe.
-		// But I need to generate a unique ID.
-		// Using "v.generateID()"
-		urlToUse = fmt.Sprintf("%s?%s_id=%s", collectionURL, r.Singular, v.GenerateID())
+	if idParam := utils.CreateIDParam(v.spec, r); idParam != "" {
+		urlToUse = fmt.Sprintf("%s?%s=%s", collectionURL, idParam, v.GenerateID())
 	}
 
 	resp, err := v.Post(urlToUse, payload)
@@ -288,9 +447,32 @@ func (v *Validator) CreateResource(r *api.Resource, collectionURL string, payloa
 	if err := json.NewDecoder(resp.Body).Decode(&createdResource); err != nil {
 		return nil, err
 	}
+
+	lro := r.Methods.Create != nil && r.Methods.Create.IsLongRunning
+	if lro || utils.IsLongRunning(v.spec, utils.CollectionPath(r), "POST") {
+		if !utils.IsOperation(createdResource) {
+			return nil, fmt.Errorf("expected an operation from long-running create, got %v", createdResource)
+		}
+		return v.WaitOperation(createdResource)
+	}
 	return createdResource, nil
 }
 
+// WaitOperation polls a long-running operation until it is done and returns
+// its response, or an error if the operation failed or timed out.
+func (v *Validator) WaitOperation(op map[string]interface{}) (map[string]interface{}, error) {
+	done, err := utils.PollOperation(v, v.serverURL, op, v.pollInterval, v.pollTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return utils.OperationResult(done)
+}
+
+// ObservedPaths returns the resource paths found in the client history.
+func (v *Validator) ObservedPaths() []tests.ObservedPath {
+	return v.client.observedPaths()
+}
+
 func (v *Validator) GenerateID() string {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	return fmt.Sprintf("test-id-%d", r.Intn(100000))
@@ -309,7 +491,30 @@ func (v *Validator) Post(url string, body interface{}) (*http.Response, error) {
 	return v.client.Do(req)
 }
 
+// PostWithQuery sends a post request with additional query parameters, such
+// as request_id, merged into the URL.
+func (v *Validator) PostWithQuery(rawURL string, body interface{}, query url.Values) (*http.Response, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	for key, values := range query {
+		for _, value := range values {
+			q.Add(key, value)
+		}
+	}
+	u.RawQuery = q.Encode()
+	return v.Post(u.String(), body)
+}
+
 func (v *Validator) Patch(url string, body interface{}) (*http.Response, error) {
+	return v.PatchWithHeaders(url, body, nil)
+}
+
+// PatchWithHeaders sends a merge patch with additional headers, such as
+// If-Match, set on this request only.
+func (v *Validator) PatchWithHeaders(url string, body interface{}, headers http.Header) (*http.Response, error) {
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
@@ -318,11 +523,25 @@ func (v *Validator) Patch(url string, body interface{}) (*http.Response, error)
 	if err != nil {
 		return nil, err
 	}
+	setHeaders(req, headers)
+	req.Header.Set("Content-Type", openapi.JSON_MERGE_PATCH)
+	return v.client.Do(req)
+}
+
+func (v *Validator) Put(url string, body interface{}) (*http.Response, error) {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, err
+	}
 	req.Header.Set("Content-Type", "application/json")
 	return v.client.Do(req)
 }
 
-func (v *Validator) getReq(url string) (*http.Response, error) {
+func (v *Validator) GetReq(url string) (*http.Response, error) {
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
@@ -331,7 +550,7 @@ func (v *Validator) getReq(url string) (*http.Response, error) {
 }
 
 func (v *Validator) Get(url string) (map[string]interface{}, error) {
-	resp, err := v.getReq(url)
+	resp, err := v.GetReq(url)
 	if err != nil {
 		return nil, err
 	}
@@ -348,13 +567,28 @@ func (v *Validator) Get(url string) (map[string]interface{}, error) {
 }
 
 func (v *Validator) DeleteReq(url string) (*http.Response, error) {
+	return v.DeleteReqWithHeaders(url, nil)
+}
+
+// DeleteReqWithHeaders sends a delete request with additional headers set on
+// this request only.
+func (v *Validator) DeleteReqWithHeaders(url string, headers http.Header) (*http.Response, error) {
 	req, err := http.NewRequest("DELETE", url, nil)
 	if err != nil {
 		return nil, err
 	}
+	setHeaders(req, headers)
 	return v.client.Do(req)
 }
 
+func setHeaders(req *http.Request, headers http.Header) {
+	for key, values := range headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+}
+
 func (v *Validator) Delete(url string) error {
 	resp, err := v.DeleteReq(url)
 	if err != nil {
@@ -365,11 +599,22 @@ func (v *Validator) Delete(url string) error {
 		body, _ := io.ReadAll(resp.Body)
 		return fmt.Errorf("status %d: %s", resp.StatusCode, string(body))
 	}
+
+	// A long-running delete returns an operation, which must complete before
+	// the resource is gone. Its response is empty, so only its error matters.
+	var op map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&op); err == nil && utils.IsOperation(op) {
+		done, err := utils.PollOperation(v, v.serverURL, op, v.pollInterval, v.pollTimeout)
+		if err != nil {
+			return err
+		}
+		return utils.OperationError(done)
+	}
 	return nil
 }
 
 func (v *Validator) List(url string) (*utils.ListResponse, error) {
-	resp, err := v.getReq(url)
+	resp, err := v.GetReq(url)
 	if err != nil {
 		return nil, err
 	}
@@ -1,11 +1,17 @@
 package validator
 
 import (
+	"io"
+	"log"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
+	"github.com/aep-dev/aep-e2e-validator/pkg/utils"
 	"github.com/aep-dev/aep-lib-go/pkg/api"
+	"github.com/aep-dev/aep-lib-go/pkg/openapi"
 )
 
 func TestCollectionURL(t *testing.T) {
@@ -92,3 +98,293 @@ func TestExtendedClientDo_NoHeaders(t *testing.T) {
 	}
 	resp.Body.Close()
 }
+
+func TestCreateResource_UsesSpecIDParam(t *testing.T) {
+	var gotQuery url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"path": "books/1"}`))
+	}))
+	defer server.Close()
+
+	a := &api.API{ServerURL: server.URL}
+	r := &api.Resource{
+		Singular: "book",
+		Plural:   "books",
+		API:      a,
+		Methods:  api.Methods{Create: &api.CreateMethod{SupportsUserSettableCreate: true}},
+	}
+
+	tests := []struct {
+		name      string
+		params    []openapi.Parameter
+		wantParam string
+	}{
+		{
+			name:      "id param",
+			params:    []openapi.Parameter{{Name: "id", In: "query"}},
+			wantParam: "id",
+		},
+		{
+			name:      "singular id param",
+			params:    []openapi.Parameter{{Name: "book_id", In: "query"}},
+			wantParam: "book_id",
+		},
+		{
+			name:      "no param declared",
+			wantParam: "id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &openapi.OpenAPI{Paths: map[string]*openapi.PathItem{
+				"/books": {Post: &openapi.Operation{Parameters: tt.params}},
+			}}
+			v := &Validator{spec: spec, client: &extendedClient{inner: &http.Client{}}}
+			if _, err := v.CreateResource(r, server.URL+"/books", map[string]interface{}{}); err != nil {
+				t.Fatal(err)
+			}
+			if got := gotQuery.Get(tt.wantParam); got == "" {
+				t.Errorf("query %v missing %q", gotQuery, tt.wantParam)
+			}
+		})
+	}
+}
+
+func TestPatch_UsesMergePatchContentType(t *testing.T) {
+	var gotContentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	v := &Validator{client: &extendedClient{inner: &http.Client{}}}
+	resp, err := v.Patch(server.URL, map[string]interface{}{"title": "t"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if gotContentType != openapi.JSON_MERGE_PATCH {
+		t.Errorf("Content-Type = %q, want %q", gotContentType, openapi.JSON_MERGE_PATCH)
+	}
+}
+
+func TestRequestWithHeaders_SetsPerRequestHeaders(t *testing.T) {
+	var gotIfMatch []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotIfMatch = append(gotIfMatch, r.Header.Get("If-Match"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	v := &Validator{client: &extendedClient{inner: &http.Client{}}}
+	headers := http.Header{"If-Match": []string{`"abc"`}}
+
+	resp, err := v.PatchWithHeaders(server.URL, map[string]interface{}{}, headers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	resp, err = v.DeleteReqWithHeaders(server.URL, headers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	resp, err = v.DeleteReq(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	want := []string{`"abc"`, `"abc"`, ""}
+	if len(gotIfMatch) != len(want) {
+		t.Fatalf("got %d requests, want %d", len(gotIfMatch), len(want))
+	}
+	for i := range want {
+		if gotIfMatch[i] != want[i] {
+			t.Errorf("request %d If-Match = %q, want %q", i, gotIfMatch[i], want[i])
+		}
+	}
+}
+
+func TestObservedPaths(t *testing.T) {
+	v := &Validator{client: &extendedClient{history: []RequestLog{
+		{Method: "POST", URL: "http://x/books", RespCode: 200, RespType: "application/json", RespBody: `{"path": "books/1"}`},
+		{Method: "GET", URL: "http://x/books", RespCode: 200, RespType: "application/json", RespBody: `{"results": [{"path": "books/1"}, {"name": "books/2"}]}`},
+		{Method: "GET", URL: "http://x/books/3", RespCode: 404, RespType: "application/json", RespBody: `{"path": "books/3"}`},
+		{Method: "DELETE", URL: "http://x/books/1", RespCode: 204},
+		{Method: "POST", URL: "http://x/books", RespCode: 200, RespType: "application/json", RespBody: `{"path": "operations/1", "done": false}`},
+		{Method: "GET", URL: "http://x/operations/1", RespCode: 200, RespType: "application/json", RespBody: `{"path": "operations/1", "done": true, "response": {"path": "books/4"}}`},
+	}}}
+
+	got := v.ObservedPaths()
+	want := []string{"books/1", "books/1", "books/2", "books/4"}
+	if len(got) != len(want) {
+		t.Fatalf("ObservedPaths() = %+v, want paths %v", got, want)
+	}
+	for i := range want {
+		if got[i].Path != want[i] {
+			t.Errorf("ObservedPaths()[%d].Path = %q, want %q", i, got[i].Path, want[i])
+		}
+	}
+}
+
+func TestCreateResource_WaitsForOperation(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST":
+			w.Write([]byte(`{"path": "operations/1", "done": false}`))
+		case r.URL.Path == "/operations/1":
+			polls++
+			if polls < 2 {
+				w.Write([]byte(`{"path": "operations/1", "done": false}`))
+				return
+			}
+			w.Write([]byte(`{"path": "operations/1", "done": true, "response": {"path": "books/1"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	a := &api.API{ServerURL: server.URL}
+	r := &api.Resource{
+		Singular: "book",
+		Plural:   "books",
+		API:      a,
+		Methods:  api.Methods{Create: &api.CreateMethod{IsLongRunning: true}},
+	}
+	v := &Validator{serverURL: server.URL, pollTimeout: time.Second, client: &extendedClient{inner: &http.Client{}}}
+
+	got, err := v.CreateResource(r, server.URL+"/books", map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got["path"] != "books/1" {
+		t.Errorf("CreateResource() = %v, want the operation response", got)
+	}
+	if polls != 2 {
+		t.Errorf("polled %d times, want 2", polls)
+	}
+}
+
+func TestWaitOperation_RequiresResponse(t *testing.T) {
+	v := &Validator{pollTimeout: time.Second}
+	_, err := v.WaitOperation(map[string]interface{}{"path": "operations/1", "done": true})
+	if err == nil {
+		t.Error("WaitOperation() of an operation without a response succeeded, want an error")
+	}
+}
+
+func TestValidateResource_RequiredReferenceFailureErrorsAllTests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": []}`))
+	}))
+	defer server.Close()
+
+	a := &api.API{ServerURL: server.URL, Resources: map[string]*api.Resource{}}
+	author := &api.Resource{Singular: "author", Plural: "authors", API: a, Schema: &openapi.Schema{}}
+	book := &api.Resource{
+		Singular: "book",
+		Plural:   "books",
+		API:      a,
+		Schema: &openapi.Schema{
+			Properties: openapi.Properties{
+				"author": {Type: "string", XAEPField: &openapi.XAEPField{ResourceReference: []string{"author"}}},
+			},
+			Required: []string{"author"},
+		},
+	}
+	a.Resources["author"] = author
+	a.Resources["book"] = book
+	logger := log.New(io.Discard, "", 0)
+	v := &Validator{
+		testNames: []string{"aep-133-create", "aep-135-delete-resource"},
+		client:    &extendedClient{inner: &http.Client{}, logger: logger},
+		logger:    logger,
+	}
+
+	results := v.validateResource(book)
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	for _, r := range results {
+		if r.Status != StatusError {
+			t.Errorf("%s status = %v, want %v", r.Name, r.Status, StatusError)
+		}
+	}
+}
+
+func TestProvisionReferences_LeavesOptionalReferencesUnset(t *testing.T) {
+	a := &api.API{Resources: map[string]*api.Resource{}}
+	author := &api.Resource{Singular: "author", Plural: "authors", API: a, Schema: &openapi.Schema{}}
+	review := &api.Resource{
+		Singular: "review",
+		Plural:   "reviews",
+		Parents:  []string{"book"},
+		API:      a,
+		Schema:   &openapi.Schema{},
+		Methods:  api.Methods{Create: &api.CreateMethod{}},
+	}
+	book := &api.Resource{
+		Singular: "book",
+		Plural:   "books",
+		API:      a,
+		Schema: &openapi.Schema{Properties: openapi.Properties{
+			"title":  {Type: "string"},
+			"author": {Type: "string", XAEPField: &openapi.XAEPField{ResourceReference: []string{"author"}}},
+			"review": {Type: "string", XAEPField: &openapi.XAEPField{ResourceReference: []string{"review"}}},
+		}},
+	}
+	a.Resources["author"] = author
+	a.Resources["review"] = review
+	a.Resources["book"] = book
+	logger := log.New(io.Discard, "", 0)
+	v := &Validator{logger: logger}
+
+	g := &utils.Generator{}
+	created, err := v.provisionReferences(g, book)
+	if err != nil {
+		t.Fatalf("provisionReferences() error = %v, want nil", err)
+	}
+	if len(created) != 0 {
+		t.Errorf("provisionReferences() created %v, want nothing", created)
+	}
+	payload, err := g.GenerateCreatePayload(book)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, field := range []string{"author", "review"} {
+		if _, ok := payload[field]; ok {
+			t.Errorf("payload %v sets %q, want it left out", payload, field)
+		}
+	}
+	if _, ok := payload["title"]; !ok {
+		t.Errorf("payload %v is missing \"title\"", payload)
+	}
+}
+
+func TestPostWithQuery_MergesQuery(t *testing.T) {
+	var gotQuery url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	v := &Validator{client: &extendedClient{inner: &http.Client{}}}
+	resp, err := v.PostWithQuery(server.URL+"/books?id=1", map[string]interface{}{}, url.Values{"request_id": []string{"abc"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if gotQuery.Get("id") != "1" || gotQuery.Get("request_id") != "abc" {
+		t.Errorf("query = %v, want id=1 and request_id=abc", gotQuery)
+	}
+}